@@ -1,48 +1,306 @@
 package main
 
 import (
-	"PlantSite/internal/infra/admins"
 	"PlantSite/internal/models/auth"
+	authstorage "PlantSite/internal/repositories/postgres/auth-storage"
+	sessionstorage "PlantSite/internal/repositories/postgres/session-storage"
 	authservice "PlantSite/internal/services/auth-service"
+	"bufio"
 	"context"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/spf13/viper"
 )
 
+const AdminCommand = "admin"
+
 const (
-	AdminsList       = "admins"
-	AdminLoginKey    = "login"
-	AdminPasswordKey = "password"
+	// LegacyAdminsKey is the admin list the api config used to have, the admins are imported once with import-config.
+	LegacyAdminsKey     = "admins"
+	LegacyAdminLoginKey = "login"
 )
 
-func GetAdminsMap(hasher authservice.PasswdHasher) *admins.AdminMap {
+const adminUsage = `usage: api admin <command> [arguments]
+
+commands:
+  create -login <login> -email <email> [-password <password>]
+                    create an admin, the password is read from stdin if not given
+  set-password -login <login> [-password <password>]
+                    set the password of an admin and end the admin sessions,
+                    the password is read from stdin if not given
+  list              list admins
+  promote <login>   make an existing user an admin
+  demote <login>    make an admin a regular user, author rights stay
+  import-config     promote the users of the former admins list of the config,
+                    run it once after upgrading from config admins
+  roles             list roles with their permissions
+  grant-role <login> <role>
+                    assign a role to a user, e.g. moderator
+  revoke-role <login> <role>
+                    take an assigned role from a user
+`
+
+// runAdminCommand manages admins stored in the database and returns the exit code.
+func runAdminCommand(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, adminUsage)
+		return 2
+	}
+
 	ctx := context.Background()
-	if err := ReadInConfig(); err != nil {
-		panic(err)
+	db := GetSqpgx(ctx)
+	repo, err := authstorage.NewPostgresAuthRepository(ctx, db)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
 	}
-	m := admins.NewAdminMap()
 
-	var admins []map[string]string
-	if err := viper.UnmarshalKey(AdminsList, &admins); err != nil {
-		panic(fmt.Errorf("error unmarshalling admins: %w", err))
+	switch args[0] {
+	case "create":
+		flags := flag.NewFlagSet("create", flag.ContinueOnError)
+		flags.SetOutput(stderr)
+		login := flags.String("login", "", "admin login")
+		email := flags.String("email", "", "admin email")
+		password := flags.String("password", "", "admin password, read from stdin if empty")
+		if err := flags.Parse(args[1:]); err != nil {
+			return 2
+		}
+		if *password == "" {
+			*password, err = readPassword(stdin)
+			if err != nil {
+				fmt.Fprintln(stderr, err)
+				return 1
+			}
+		}
+		var policy *authservice.PasswordPolicy
+		policy, err = NewPasswordPolicy()
+		if err == nil {
+			err = policy.Check(*password)
+		}
+		if err == nil {
+			err = createAdmin(ctx, repo, NewPasswdHasher(), *login, *email, *password)
+		}
+	case "set-password":
+		flags := flag.NewFlagSet("set-password", flag.ContinueOnError)
+		flags.SetOutput(stderr)
+		login := flags.String("login", "", "admin login")
+		password := flags.String("password", "", "new password, read from stdin if empty")
+		if err := flags.Parse(args[1:]); err != nil {
+			return 2
+		}
+		if *password == "" {
+			*password, err = readPassword(stdin)
+			if err != nil {
+				fmt.Fprintln(stderr, err)
+				return 1
+			}
+		}
+		var policy *authservice.PasswordPolicy
+		policy, err = NewPasswordPolicy()
+		if err == nil {
+			err = policy.Check(*password)
+		}
+		var sessions *sessionstorage.PostgresSessionStorage
+		if err == nil {
+			sessions, err = sessionstorage.NewPostgresSessionStorage(ctx, db)
+		}
+		if err == nil {
+			err = setAdminPassword(ctx, repo, sessions, NewPasswdHasher(), *login, *password)
+		}
+	case "list":
+		var admins []*auth.Admin
+		admins, err = repo.ListAdmins(ctx)
+		for _, admin := range admins {
+			fmt.Fprintf(stdout, "%s\t%s\n", admin.ID(), admin.Login())
+		}
+	case "promote":
+		if len(args) != 2 {
+			fmt.Fprint(stderr, adminUsage)
+			return 2
+		}
+		err = promoteAdmin(ctx, repo, args[1])
+	case "demote":
+		if len(args) != 2 {
+			fmt.Fprint(stderr, adminUsage)
+			return 2
+		}
+		err = demoteAdmin(ctx, repo, args[1])
+	case "import-config":
+		if len(args) != 1 {
+			fmt.Fprint(stderr, adminUsage)
+			return 2
+		}
+		err = importConfigAdmins(ctx, repo, stdout)
+	case "roles":
+		var roles []auth.Role
+		roles, err = repo.ListRoles(ctx)
+		for _, role := range roles {
+			perms := make([]string, 0, len(role.Permissions))
+			for _, perm := range role.Permissions {
+				perms = append(perms, string(perm))
+			}
+			fmt.Fprintf(stdout, "%s\t%s\n", role.Name, strings.Join(perms, ","))
+		}
+	case "grant-role", "revoke-role":
+		if len(args) != 3 {
+			fmt.Fprint(stderr, adminUsage)
+			return 2
+		}
+		err = changeRole(ctx, repo, args[1], args[2], args[0] == "grant-role")
+	default:
+		fmt.Fprint(stderr, adminUsage)
+		return 2
+	}
+
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
 	}
+	return 0
+}
 
+func readPassword(stdin io.Reader) (string, error) {
+	line, err := bufio.NewReader(stdin).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+	password := strings.TrimRight(line, "\r\n")
+	if password == "" {
+		return "", errors.New("empty password")
+	}
+	return password, nil
+}
+
+// createAdmin registers a user with a verified email and makes it an admin.
+func createAdmin(ctx context.Context, repo *authstorage.PostgresAuthRepository, hasher authservice.PasswdHasher, login, email, password string) error {
+	hashedPasswd, err := hasher.Hash([]byte(password))
+	if err != nil {
+		return err
+	}
+	member, err := auth.NewMember(login, email, hashedPasswd)
+	if err != nil {
+		return err
+	}
+	member.MarkEmailVerified()
+	if _, err := repo.Create(ctx, member); err != nil {
+		return err
+	}
+	return promoteAdmin(ctx, repo, login)
+}
+
+// promoteAdmin makes the user an admin. Admins publish posts, so the user becomes an author as well.
+func promoteAdmin(ctx context.Context, repo *authstorage.PostgresAuthRepository, login string) error {
+	user, err := repo.GetByName(ctx, login)
+	if err != nil {
+		return err
+	}
+	if auth.IsAdmin(user) {
+		return fmt.Errorf("%s is already an admin", login)
+	}
+	_, err = repo.Update(ctx, user.ID(), func(user auth.User) (auth.User, error) {
+		switch fact := user.(type) {
+		case *auth.Member:
+			return auth.CreateAuthor(*fact, time.Now(), true, time.Time{})
+		case *auth.Author:
+			if !fact.HasRights() {
+				fact.GrantRights(true)
+			}
+			return fact, nil
+		default:
+			return nil, fmt.Errorf("unsupported user type: %v", user)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	return repo.SetAdmin(ctx, user.ID(), true)
+}
+
+// importConfigAdmins promotes the users the api synced from the admins list of the config,
+// admins listed there that never logged in have no user and are skipped.
+func importConfigAdmins(ctx context.Context, repo *authstorage.PostgresAuthRepository, stdout io.Writer) error {
+	var admins []map[string]string
+	if err := viper.UnmarshalKey(LegacyAdminsKey, &admins); err != nil {
+		return fmt.Errorf("error unmarshalling admins: %w", err)
+	}
 	for _, admin := range admins {
-		login := admin[AdminLoginKey]
-		password := admin[AdminPasswordKey]
-		passwdHash, err := hasher.Hash([]byte(password))
-		if err != nil {
-			panic(fmt.Errorf("error hashing admin password: %w", err))
+		login := admin[LegacyAdminLoginKey]
+		if login == "" {
+			continue
+		}
+		user, err := repo.GetByName(ctx, login)
+		if errors.Is(err, auth.ErrUserNotFound) {
+			fmt.Fprintf(stdout, "%s\tskipped, no such user\n", login)
+			continue
+		} else if err != nil {
+			return err
+		}
+		if auth.IsAdmin(user) {
+			fmt.Fprintf(stdout, "%s\talready an admin\n", login)
+			continue
 		}
-		adm, err := auth.NewAdmin(login, passwdHash)
-		if err != nil {
-			panic(fmt.Errorf("error creating admin: %w", err))
+		if err := promoteAdmin(ctx, repo, login); err != nil {
+			return err
 		}
-		if err := m.Add(ctx, *adm); err != nil {
-			panic(fmt.Errorf("error adding admin: %w", err))
+		fmt.Fprintf(stdout, "%s\tpromoted\n", login)
+	}
+	return nil
+}
+
+// setAdminPassword replaces the password of the admin, the sessions started with the old one end.
+func setAdminPassword(ctx context.Context, repo *authstorage.PostgresAuthRepository, sessions authservice.SessionStorage, hasher authservice.PasswdHasher, login, password string) error {
+	user, err := repo.GetByName(ctx, login)
+	if err != nil {
+		return err
+	}
+	if !auth.IsAdmin(user) {
+		return fmt.Errorf("%s is not an admin", login)
+	}
+	hashedPasswd, err := hasher.Hash([]byte(password))
+	if err != nil {
+		return err
+	}
+	_, err = repo.Update(ctx, user.ID(), func(user auth.User) (auth.User, error) {
+		admin, ok := user.(*auth.Admin)
+		if !ok {
+			return nil, fmt.Errorf("%s is not an admin", login)
 		}
+		return admin, admin.UpdateHashedPassword(hashedPasswd)
+	})
+	if err != nil {
+		return err
+	}
+	return sessions.DeleteByMember(ctx, user.ID(), uuid.Nil)
+}
+
+func demoteAdmin(ctx context.Context, repo *authstorage.PostgresAuthRepository, login string) error {
+	user, err := repo.GetByName(ctx, login)
+	if err != nil {
+		return err
 	}
+	if !auth.IsAdmin(user) {
+		return fmt.Errorf("%s is not an admin", login)
+	}
+	return repo.SetAdmin(ctx, user.ID(), false)
+}
 
-	return m
+// changeRole assigns or takes the role, the member and author roles come with the user type and can't be assigned.
+func changeRole(ctx context.Context, repo *authstorage.PostgresAuthRepository, login, role string, grant bool) error {
+	if role == auth.RoleMember || role == auth.RoleAuthor {
+		return fmt.Errorf("%s role can't be assigned", role)
+	}
+	user, err := repo.GetByName(ctx, login)
+	if err != nil {
+		return err
+	}
+	if grant {
+		return repo.AssignRole(ctx, user.ID(), role)
+	}
+	return repo.UnassignRole(ctx, user.ID(), role)
 }
@@ -9,6 +9,9 @@ const (
 	ApiStaticKey       = "static"
 	ApiMediaKey        = "media"
 	ApiMediaStorageKey = "media-storage"
+	// ApiTrustedProxiesKey lists the proxies whose X-Forwarded-For is believed,
+	// without them the client IP is the address of the connection.
+	ApiTrustedProxiesKey = "trusted_proxies"
 )
 
 const (
@@ -58,3 +61,10 @@ func GetMediaStorage() string {
 		panic("unknown media storage")
 	}
 }
+
+func GetTrustedProxies() []string {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	return viper.GetStringSlice(Key(ApiPrefix, ApiTrustedProxiesKey))
+}
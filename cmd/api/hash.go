@@ -1,15 +1,81 @@
 package main
 
-import "github.com/spf13/viper"
+import (
+	authservice "PlantSite/internal/services/auth-service"
+	"PlantSite/internal/utils/argon2hasher"
+	"PlantSite/internal/utils/bcrypthasher"
+	"PlantSite/internal/utils/multihasher"
+
+	"github.com/spf13/viper"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	HashPrefix       = "hasher"
+	HashAlgorithmKey = "algorithm"
+	HashCostKey      = "hash_cost"
+
+	Argon2MemoryKey      = "argon2_memory"
+	Argon2IterationsKey  = "argon2_iterations"
+	Argon2ParallelismKey = "argon2_parallelism"
+)
 
 const (
-	HashPrefix  = "hasher"
-	HashCostKey = "hash_cost"
+	HashAlgorithmBcrypt   = "bcrypt"
+	HashAlgorithmArgon2id = "argon2id"
 )
 
+func GetHashAlgorithm() string {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	algorithm := viper.GetString(Key(HashPrefix, HashAlgorithmKey))
+	switch algorithm {
+	// bcrypt was the only algorithm, configs without one keep it
+	case HashAlgorithmBcrypt, "":
+		return HashAlgorithmBcrypt
+	case HashAlgorithmArgon2id:
+		return HashAlgorithmArgon2id
+	default:
+		panic("unknown hash algorithm")
+	}
+}
+
 func GetHashCost() int {
 	if err := ReadInConfig(); err != nil {
 		panic(err)
 	}
 	return viper.GetInt(Key(HashPrefix, HashCostKey))
 }
+
+// GetArgon2Params returns the configured argon2id params, unset ones are the defaults.
+func GetArgon2Params() argon2hasher.Params {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	params := argon2hasher.DefaultParams
+	if memory := viper.GetUint32(Key(HashPrefix, Argon2MemoryKey)); memory > 0 {
+		params.Memory = memory
+	}
+	if iterations := viper.GetUint32(Key(HashPrefix, Argon2IterationsKey)); iterations > 0 {
+		params.Iterations = iterations
+	}
+	if parallelism := viper.GetUint8(Key(HashPrefix, Argon2ParallelismKey)); parallelism > 0 {
+		params.Parallelism = parallelism
+	}
+	return params
+}
+
+// NewPasswdHasher hashes with the configured algorithm and verifies the hashes of both algorithms,
+// logins upgrade the hashes of the other one.
+func NewPasswdHasher() authservice.PasswdHasher {
+	if GetHashAlgorithm() == HashAlgorithmArgon2id {
+		// Only verifies the old hashes, the cost doesn't matter then
+		cost := GetHashCost()
+		if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+			cost = bcrypt.DefaultCost
+		}
+		return multihasher.NewMultiHasher(argon2hasher.NewArgon2Hasher(GetArgon2Params()), bcrypthasher.NewBcryptHasher(cost))
+	}
+	return multihasher.NewMultiHasher(bcrypthasher.NewBcryptHasher(GetHashCost()), argon2hasher.NewArgon2Hasher(GetArgon2Params()))
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"PlantSite/internal/infra/mailer"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	MailPrefix      = "mail"
+	MailTypeKey     = "type"
+	MailFromKey     = "from"
+	MailOutboxKey   = "outbox"
+	SMTPPrefix      = "smtp"
+	SMTPHostKey     = "host"
+	SMTPPortKey     = "port"
+	SMTPUserKey     = "user"
+	SMTPPasswordKey = "password"
+	MailResetURLKey = "reset_url"
+
+	MailVerifyURLKey = "verify_url"
+)
+
+const (
+	MailTypeSMTP   = "smtp"
+	MailTypeOutbox = "outbox"
+)
+
+func GetMailType() string {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	mailType := viper.GetString(Key(MailPrefix, MailTypeKey))
+	switch mailType {
+	case MailTypeSMTP:
+		return MailTypeSMTP
+	case MailTypeOutbox:
+		return MailTypeOutbox
+	default:
+		panic("unknown mail type")
+	}
+}
+
+func GetMailFrom() string {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	return viper.GetString(Key(MailPrefix, MailFromKey))
+}
+
+func GetMailOutbox() string {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	return viper.GetString(Key(MailPrefix, MailOutboxKey))
+}
+
+func GetSMTPConfig() mailer.SMTPConfig {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	pref := Key(MailPrefix, SMTPPrefix)
+	return mailer.SMTPConfig{
+		Host:     viper.GetString(Key(pref, SMTPHostKey)),
+		Port:     viper.GetUint(Key(pref, SMTPPortKey)),
+		User:     viper.GetString(Key(pref, SMTPUserKey)),
+		Password: viper.GetString(Key(pref, SMTPPasswordKey)),
+		From:     viper.GetString(Key(MailPrefix, MailFromKey)),
+	}
+}
+
+func GetPasswordResetURL() string {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	return viper.GetString(Key(MailPrefix, MailResetURLKey))
+}
+
+func GetEmailVerificationURL() string {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	return viper.GetString(Key(MailPrefix, MailVerifyURLKey))
+}
@@ -1,35 +1,49 @@
 package main
 
 import (
+	"PlantSite/internal/api-utils/csrf"
 	"PlantSite/internal/api-utils/urllib"
+	adminapi "PlantSite/internal/api/admin-api"
 	albumapi "PlantSite/internal/api/album-api"
 	authapi "PlantSite/internal/api/auth-api"
 	"PlantSite/internal/api/middleware"
 	plantapi "PlantSite/internal/api/plant-api"
 	postapi "PlantSite/internal/api/post-api"
 	searchapi "PlantSite/internal/api/search-api"
+	attemptstorage "PlantSite/internal/infra/attempt-storage"
+	"PlantSite/internal/infra/mailer"
 	minioclient "PlantSite/internal/infra/minio-client"
+	"PlantSite/internal/infra/oidc"
 	filedir "PlantSite/internal/infra/os/file-dir"
 	sessionstorage "PlantSite/internal/infra/session-storage"
 	"PlantSite/internal/models"
+	"PlantSite/internal/models/auth"
 	authrepo "PlantSite/internal/repositories/authrepo"
 	miniofilestorage "PlantSite/internal/repositories/pgminio/file-storage"
 	fsfilestorage "PlantSite/internal/repositories/pgos/file-storage"
 	albumstorage "PlantSite/internal/repositories/postgres/album-storage"
+	pgapitokenstorage "PlantSite/internal/repositories/postgres/api-token-storage"
+	pgattemptstorage "PlantSite/internal/repositories/postgres/attempt-storage"
 	authstorage "PlantSite/internal/repositories/postgres/auth-storage"
+	pgidentitystorage "PlantSite/internal/repositories/postgres/identity-storage"
 	plantstorage "PlantSite/internal/repositories/postgres/plant-storage"
 	poststorage "PlantSite/internal/repositories/postgres/post-storage"
 	searchstorage "PlantSite/internal/repositories/postgres/search-storage"
+	pgsessionstorage "PlantSite/internal/repositories/postgres/session-storage"
+	pgtokenstorage "PlantSite/internal/repositories/postgres/token-storage"
+	adminservice "PlantSite/internal/services/admin-service"
 	albumservice "PlantSite/internal/services/album-service"
 	authservice "PlantSite/internal/services/auth-service"
 	plantservice "PlantSite/internal/services/plant-service"
 	postservice "PlantSite/internal/services/post-service"
 	searchservice "PlantSite/internal/services/search-service"
-	"PlantSite/internal/utils/bcrypthasher"
 	"PlantSite/internal/utils/logs"
 	"PlantSite/internal/view"
 	"context"
 	"fmt"
+	"net/http"
+	"os"
+	"time"
 
 	docs "PlantSite/cmd/docs"
 
@@ -39,9 +53,17 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == AdminCommand {
+		os.Exit(runAdminCommand(os.Args[2:], os.Stdin, os.Stdout, os.Stderr))
+	}
+
 	fmt.Println(GetPlantMinioConfig())
 	ctx := context.Background()
 	engine := gin.New()
+	// The client IP keys the login throttling, so only the configured proxies may set it
+	if err := engine.SetTrustedProxies(GetTrustedProxies()); err != nil {
+		panic(fmt.Errorf("invalid trusted proxies: %w", err))
+	}
 
 	docs.SwaggerInfo.BasePath = "/api"
 	engine.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
@@ -127,25 +149,173 @@ func main() {
 	}
 
 	// ------------- AUTH STORAGE -------------
-	sessStorage := sessionstorage.NewMapSessionStorage()
-	hasher := bcrypthasher.NewBcryptHasher(GetHashCost())
+	var sessStorage authservice.SessionStorage
+	var attemptStorage authservice.LoginAttemptStorage
+
+	// Sessions and login attempts may live in memory, losing them on restart only logs members out
+	switch GetSessionStorage() {
+	case SessionStorageMemory:
+		logg.Info("Choosed in-memory session storage")
+		sessStorage = sessionstorage.NewMapSessionStorage()
+		attemptStorage = attemptstorage.NewMapLoginAttemptStorage()
+	case SessionStoragePostgres:
+		logg.Info("Choosed postgres session storage")
+		sessStorage, err = pgsessionstorage.NewPostgresSessionStorage(ctx, sqpgx)
+		if err != nil {
+			panic(err)
+		}
+		attemptStorage, err = pgattemptstorage.NewPostgresLoginAttemptStorage(ctx, sqpgx)
+		if err != nil {
+			panic(err)
+		}
+	default:
+		panic("unknown session storage")
+	}
+
+	// Issued tokens and linked accounts must survive restarts
+	resetStorage, err := pgtokenstorage.NewPostgresMemberTokenStorage(ctx, sqpgx, pgtokenstorage.PasswordResetTable)
+	if err != nil {
+		panic(err)
+	}
+	verifyStorage, err := pgtokenstorage.NewPostgresMemberTokenStorage(ctx, sqpgx, pgtokenstorage.EmailVerificationTable)
+	if err != nil {
+		panic(err)
+	}
+	apiTokenStorage, err := pgapitokenstorage.NewPostgresAPITokenStorage(ctx, sqpgx)
+	if err != nil {
+		panic(err)
+	}
+	identityStorage, err := pgidentitystorage.NewPostgresExternalIdentityStorage(ctx, sqpgx)
+	if err != nil {
+		panic(err)
+	}
+	sessionstorage.RunJanitor(ctx, sessStorage, GetSessionClearInterval(), logg)
+	sessionstorage.RunJanitor(ctx, resetStorage, GetSessionClearInterval(), logg)
+	sessionstorage.RunJanitor(ctx, verifyStorage, GetSessionClearInterval(), logg)
+	sessionstorage.RunJanitor(ctx, attemptStorage, GetSessionClearInterval(), logg)
+
+	// ------------- MAIL -------------
+	var mail authservice.Mailer
+
+	switch GetMailType() {
+	case MailTypeSMTP:
+		logg.Info("Choosed smtp mailer")
+		mail, err = mailer.NewSMTPMailer(GetSMTPConfig())
+		if err != nil {
+			panic(err)
+		}
+	case MailTypeOutbox:
+		logg.Info("Choosed outbox mailer")
+		mail, err = mailer.NewOutboxMailer(GetMailOutbox(), GetMailFrom())
+		if err != nil {
+			panic(err)
+		}
+	default:
+		panic("unknown mail type")
+	}
+
+	hasher := NewPasswdHasher()
+	passwordPolicy, err := NewPasswordPolicy()
+	if err != nil {
+		panic(err)
+	}
+	authservice.UpdatePasswordPolicy(passwordPolicy)
 	authRepo, err := authstorage.NewPostgresAuthRepository(ctx, sqpgx)
 	if err != nil {
 		panic(err)
 	}
 
-	adminsMap := GetAdminsMap(hasher)
-	storageWithAdmins := authrepo.NewWithAdminRepository(adminsMap, authRepo)
-	logg.Info("admins map initialized")
+	// Users are loaded on every request, a short cache saves the queries
+	var users auth.AuthRepository = authRepo
+	if ttl := GetUserCacheTTL(); ttl > 0 {
+		users = authrepo.NewCachedRepository(authRepo, ttl)
+	}
+	storageWithAdmins := authrepo.NewWithAdminRepository(users)
+
+	roles, err := authRepo.ListRoles(ctx)
+	if err != nil {
+		panic(err)
+	}
+	authservice.UpdatePolicy(auth.NewPolicy(roles))
 
 	// ------------- AUTH -------------
 	authservice.UpdateSessionExpireTime(GetSessionExpireTime())
+	authservice.UpdateSessionMaxLifetime(GetSessionMaxLifetime())
+	authservice.UpdateRememberSessionExpireTime(GetRememberSessionExpireTime())
+	authservice.UpdateRememberSessionMaxLifetime(GetRememberSessionMaxLifetime())
+	authapi.UpdateSessionCookie(authapi.CookieConfig{
+		Secure:   GetCookieSecure(),
+		SameSite: GetCookieSameSite(),
+		Domain:   GetCookieDomain(),
+	})
 	authService := authservice.NewAuthService(sessStorage, storageWithAdmins, hasher)
+	apiTokenService := authservice.NewAPITokenService(apiTokenStorage, authService)
+
+	csrfSecret := []byte(GetCSRFSecret())
+	if len(csrfSecret) == 0 {
+		// Replicas sharing postgres sessions must sign csrf tokens with the same secret
+		if GetSessionStorage() == SessionStoragePostgres {
+			panic("auth.csrf_secret is required with postgres session storage")
+		}
+		logg.Warn("No csrf secret configured, using a random one, csrf tokens won't survive restarts")
+		csrfSecret = csrf.NewRandomSecret()
+	}
+	csrfProtector := csrf.NewProtector(csrfSecret)
+
+	apiGroup.Use(middleware.AuthMiddleware(authService, apiTokenService))
+	apiGroup.Use(middleware.CSRFMiddleware(csrfProtector))
 
-	apiGroup.Use(middleware.AuthMiddleware(authService))
+	authservice.UpdateEmailVerificationTokenExpireTime(GetEmailVerificationExpireTime())
+	authservice.UpdateEmailVerificationURL(GetEmailVerificationURL())
+	verifyService := authservice.NewEmailVerificationService(verifyStorage, storageWithAdmins, mail)
+
+	authservice.UpdateLoginIdentifierFreeAttempts(GetLoginFreeAttempts())
+	authservice.UpdateLoginIPFreeAttempts(GetLoginIPFreeAttempts())
+	authservice.UpdateLoginBackoffBase(GetLoginBackoffBase())
+	authservice.UpdateLoginLockoutTime(GetLoginLockoutTime())
+	authservice.UpdateLoginAttemptWindow(GetLoginAttemptWindow())
+	loginLimiter := authservice.NewLoginLimiter(authService, attemptStorage, logg)
 
 	authRouter := authapi.AuthRouter{}
-	authRouter.Init(apiGroup, authService)
+	authRouter.Init(apiGroup, authService, verifyService, loginLimiter)
+
+	verifyRouter := authapi.EmailVerificationRouter{}
+	verifyRouter.Init(apiGroup, verifyService)
+
+	apiTokenRouter := authapi.APITokenRouter{}
+	apiTokenRouter.Init(apiGroup, apiTokenService)
+
+	profileRouter := authapi.ProfileRouter{}
+	profileRouter.Init(apiGroup, authService, verifyService)
+
+	sessionRouter := authapi.SessionRouter{}
+	sessionRouter.Init(apiGroup, authService)
+
+	authservice.UpdatePasswordResetTokenExpireTime(GetPasswordResetExpireTime())
+	authservice.UpdatePasswordResetURL(GetPasswordResetURL())
+	resetService := authservice.NewPasswordResetService(resetStorage, sessStorage, storageWithAdmins, hasher, mail)
+
+	resetRouter := authapi.PasswordResetRouter{}
+	resetRouter.Init(apiGroup, resetService)
+
+	if oidcConfig := GetOIDCConfig(); oidcConfig.Issuer != "" {
+		logg.Info("Enabled login with the identity provider")
+		provider, err := oidc.NewProvider(ctx, oidcConfig, &http.Client{Timeout: 10 * time.Second})
+		if err != nil {
+			panic(err)
+		}
+		oidcService := authservice.NewOIDCService(provider, identityStorage, authService)
+
+		oidcRouter := authapi.OIDCRouter{}
+		oidcRouter.Init(apiGroup, oidcService)
+		view.UpdateOIDCLoginURL(GetApiUrlPrefix() + "/auth/oidc/login")
+	}
+
+	// ------------- ADMIN -------------
+	adminService := adminservice.NewAdminService(authRepo, storageWithAdmins, authService)
+
+	adminRouter := adminapi.AdminRouter{}
+	adminRouter.Init(apiGroup, adminService)
 
 	// ------------- SEARCH STORAGE -------------
 	searchRepo, err := searchstorage.NewPostgresSearchRepository(ctx, sqpgx)
@@ -172,6 +342,7 @@ func main() {
 	}
 
 	// ------------- PLANTS -------------
+	plantservice.UpdateLogger(logg)
 	plantService := plantservice.NewPlantService(plantRepo, plantCategoryRepo, plantFStorage, authService)
 
 	plantRouter := plantapi.PlantRouter{}
@@ -184,6 +355,7 @@ func main() {
 	searchRouter.Init(apiGroup, searchService)
 
 	// ------------- POSTS -------------
+	postservice.UpdateLogger(logg)
 	postservice := postservice.NewPostService(postRepo, postFStorage, authService)
 
 	postRouter := postapi.PostRouter{}
@@ -206,7 +378,8 @@ func main() {
 	viewGroup := engine.Group("")
 	viewGroup.Use(middleware.RequestIDMiddleware())
 	viewGroup.Use(middleware.LogMiddleware(logg))
-	viewGroup.Use(middleware.AuthMiddleware(authService))
+	viewGroup.Use(middleware.AuthMiddleware(authService, apiTokenService))
+	viewGroup.Use(middleware.CSRFMiddleware(csrfProtector))
 
 	mediaStrategy := &urllib.StaticUrlStrategy{BaseUrl: GetMediaPath()}
 
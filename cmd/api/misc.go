@@ -1,14 +1,55 @@
 package main
 
 import (
+	authservice "PlantSite/internal/services/auth-service"
+	"fmt"
+	"net/http"
+	"os"
 	"time"
 
 	"github.com/spf13/viper"
 )
 
 const (
-	AuthPrefix           = "auth"
-	SessionExpireTimeKey = "session_expire_time"
+	AuthPrefix              = "auth"
+	SessionExpireTimeKey    = "session_expire_time"
+	SessionStorageKey       = "session_storage"
+	SessionClearIntervalKey = "session_clear_interval"
+
+	SessionMaxLifetimeKey         = "session_max_lifetime"
+	RememberSessionExpireTimeKey  = "remember_session_expire_time"
+	RememberSessionMaxLifetimeKey = "remember_session_max_lifetime"
+
+	CookieSecureKey   = "cookie_secure"
+	CookieSameSiteKey = "cookie_same_site"
+	CookieDomainKey   = "cookie_domain"
+
+	PasswordResetExpireTimeKey     = "password_reset_expire_time"
+	EmailVerificationExpireTimeKey = "email_verification_expire_time"
+
+	LoginFreeAttemptsKey   = "login_free_attempts"
+	LoginIPFreeAttemptsKey = "login_ip_free_attempts"
+	LoginBackoffBaseKey    = "login_backoff_base"
+	LoginLockoutTimeKey    = "login_lockout_time"
+	LoginAttemptWindowKey  = "login_attempt_window"
+
+	CSRFSecretKey = "csrf_secret"
+
+	UserCacheTTLKey = "user_cache_ttl"
+
+	PasswordMinLengthKey    = "password_min_length"
+	PasswordDenylistFileKey = "password_denylist_file"
+)
+
+const (
+	CookieSameSiteLax    = "lax"
+	CookieSameSiteStrict = "strict"
+	CookieSameSiteNone   = "none"
+)
+
+const (
+	SessionStorageMemory   = "memory"
+	SessionStoragePostgres = "postgres"
 )
 
 func GetSessionExpireTime() time.Duration {
@@ -17,3 +58,178 @@ func GetSessionExpireTime() time.Duration {
 	}
 	return viper.GetDuration(Key(AuthPrefix, SessionExpireTimeKey))
 }
+
+func GetSessionStorage() string {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	sessionStorage := viper.GetString(Key(AuthPrefix, SessionStorageKey))
+	switch sessionStorage {
+	case SessionStorageMemory:
+		return SessionStorageMemory
+	case SessionStoragePostgres:
+		return SessionStoragePostgres
+	default:
+		panic("unknown session storage")
+	}
+}
+
+func GetSessionClearInterval() time.Duration {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	return viper.GetDuration(Key(AuthPrefix, SessionClearIntervalKey))
+}
+
+func GetSessionMaxLifetime() time.Duration {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	return viper.GetDuration(Key(AuthPrefix, SessionMaxLifetimeKey))
+}
+
+func GetRememberSessionExpireTime() time.Duration {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	return viper.GetDuration(Key(AuthPrefix, RememberSessionExpireTimeKey))
+}
+
+func GetRememberSessionMaxLifetime() time.Duration {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	return viper.GetDuration(Key(AuthPrefix, RememberSessionMaxLifetimeKey))
+}
+
+func GetCookieSecure() bool {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	return viper.GetBool(Key(AuthPrefix, CookieSecureKey))
+}
+
+func GetCookieSameSite() http.SameSite {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	sameSite := viper.GetString(Key(AuthPrefix, CookieSameSiteKey))
+	switch sameSite {
+	case CookieSameSiteLax:
+		return http.SameSiteLaxMode
+	case CookieSameSiteStrict:
+		return http.SameSiteStrictMode
+	case CookieSameSiteNone:
+		return http.SameSiteNoneMode
+	default:
+		panic("unknown cookie same site mode")
+	}
+}
+
+func GetCookieDomain() string {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	return viper.GetString(Key(AuthPrefix, CookieDomainKey))
+}
+
+func GetPasswordResetExpireTime() time.Duration {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	return viper.GetDuration(Key(AuthPrefix, PasswordResetExpireTimeKey))
+}
+
+func GetEmailVerificationExpireTime() time.Duration {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	return viper.GetDuration(Key(AuthPrefix, EmailVerificationExpireTimeKey))
+}
+
+func GetLoginFreeAttempts() int {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	return viper.GetInt(Key(AuthPrefix, LoginFreeAttemptsKey))
+}
+
+func GetLoginIPFreeAttempts() int {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	return viper.GetInt(Key(AuthPrefix, LoginIPFreeAttemptsKey))
+}
+
+func GetLoginBackoffBase() time.Duration {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	return viper.GetDuration(Key(AuthPrefix, LoginBackoffBaseKey))
+}
+
+func GetLoginLockoutTime() time.Duration {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	return viper.GetDuration(Key(AuthPrefix, LoginLockoutTimeKey))
+}
+
+func GetLoginAttemptWindow() time.Duration {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	return viper.GetDuration(Key(AuthPrefix, LoginAttemptWindowKey))
+}
+
+func GetCSRFSecret() string {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	return viper.GetString(Key(AuthPrefix, CSRFSecretKey))
+}
+
+func GetUserCacheTTL() time.Duration {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	return viper.GetDuration(Key(AuthPrefix, UserCacheTTLKey))
+}
+
+func GetPasswordMinLength() int {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	return viper.GetInt(Key(AuthPrefix, PasswordMinLengthKey))
+}
+
+func GetPasswordDenylistFile() string {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	return viper.GetString(Key(AuthPrefix, PasswordDenylistFileKey))
+}
+
+// defaultPasswordMinLength is used when the config has no minimum length.
+const defaultPasswordMinLength = 8
+
+// NewPasswordPolicy makes the configured password policy.
+func NewPasswordPolicy() (*authservice.PasswordPolicy, error) {
+	minLength := GetPasswordMinLength()
+	if minLength <= 0 {
+		minLength = defaultPasswordMinLength
+	}
+	var denylist []string
+	if path := GetPasswordDenylistFile(); path != "" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("password denylist open failed %w", err)
+		}
+		defer file.Close()
+		denylist, err = authservice.ReadPasswordDenylist(file)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return authservice.NewPasswordPolicy(minLength, denylist), nil
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"PlantSite/internal/infra/oidc"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	OIDCPrefix          = "oidc"
+	OIDCIssuerKey       = "issuer"
+	OIDCClientIDKey     = "client_id"
+	OIDCClientSecretKey = "client_secret"
+	OIDCRedirectURLKey  = "redirect_url"
+)
+
+// GetOIDCConfig returns the identity provider config, login with the provider is disabled without an issuer.
+func GetOIDCConfig() oidc.Config {
+	if err := ReadInConfig(); err != nil {
+		panic(err)
+	}
+	return oidc.Config{
+		Issuer:       viper.GetString(Key(OIDCPrefix, OIDCIssuerKey)),
+		ClientID:     viper.GetString(Key(OIDCPrefix, OIDCClientIDKey)),
+		ClientSecret: viper.GetString(Key(OIDCPrefix, OIDCClientSecretKey)),
+		RedirectURL:  viper.GetString(Key(OIDCPrefix, OIDCRedirectURLKey)),
+	}
+}
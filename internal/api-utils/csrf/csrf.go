@@ -0,0 +1,61 @@
+package csrf
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+
+	"github.com/google/uuid"
+)
+
+const (
+	HeaderName = "X-CSRF-Token"
+	FormField  = "csrf_token"
+)
+
+// Protector issues CSRF tokens bound to a session.
+// The token is an HMAC of the session ID, so it needs no storage
+// and stops working together with the session.
+type Protector struct {
+	secret []byte
+}
+
+func NewProtector(secret []byte) *Protector {
+	if len(secret) == 0 {
+		panic("empty csrf secret")
+	}
+	return &Protector{secret: secret}
+}
+
+// NewRandomSecret is a secret for a single API instance, tokens don't survive its restart.
+func NewRandomSecret() []byte {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		panic(err)
+	}
+	return secret
+}
+
+func (p *Protector) Token(sid uuid.UUID) string {
+	mac := hmac.New(sha256.New, p.secret)
+	mac.Write(sid[:])
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (p *Protector) Valid(sid uuid.UUID, token string) bool {
+	return hmac.Equal([]byte(p.Token(sid)), []byte(token))
+}
+
+type csrfContextKey struct{}
+
+func WithToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, csrfContextKey{}, token)
+}
+
+// TokenFromContext returns the token of the request session, empty without a session.
+func TokenFromContext(ctx context.Context) string {
+	token, _ := ctx.Value(csrfContextKey{}).(string)
+	return token
+}
@@ -0,0 +1,21 @@
+package csrf_test
+
+import (
+	"PlantSite/internal/api-utils/csrf"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToken(t *testing.T) {
+	p := csrf.NewProtector([]byte("secret"))
+	sid := uuid.New()
+
+	token := p.Token(sid)
+
+	assert.True(t, p.Valid(sid, token))
+	assert.False(t, p.Valid(uuid.New(), token))
+	assert.False(t, p.Valid(sid, ""))
+	assert.False(t, csrf.NewProtector([]byte("other")).Valid(sid, token))
+}
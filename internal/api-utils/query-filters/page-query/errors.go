@@ -0,0 +1,9 @@
+package pagequery
+
+import "errors"
+
+var (
+	ErrInvalidLimit  = errors.New("invalid limit")
+	ErrInvalidCursor = errors.New("invalid cursor")
+	ErrInvalidSort   = errors.New("invalid sort")
+)
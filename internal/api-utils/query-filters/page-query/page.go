@@ -0,0 +1,84 @@
+package pagequery
+
+import (
+	"PlantSite/internal/models/search"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	LimitParam  = "limit"
+	CursorParam = "cursor"
+	SortParam   = "sort"
+)
+
+const (
+	DefaultLimit = 24
+	MaxLimit     = 100
+)
+
+func IsPageParam(name string) bool {
+	return name == LimitParam || name == CursorParam || name == SortParam
+}
+
+// ParsePage reads limit and cursor query parameters.
+// Cursor is an opaque value returned by NextCursor, empty cursor means the first page.
+func ParsePage(params url.Values) (search.Page, error) {
+	limit := DefaultLimit
+	if v := params.Get(LimitParam); v != "" {
+		l, err := strconv.Atoi(v)
+		if err != nil || l <= 0 || l > MaxLimit {
+			return search.Page{}, fmt.Errorf("%w: %v", ErrInvalidLimit, v)
+		}
+		limit = l
+	}
+	offset := 0
+	if v := params.Get(CursorParam); v != "" {
+		o, err := strconv.Atoi(v)
+		if err != nil || o < 0 {
+			return search.Page{}, fmt.Errorf("%w: %v", ErrInvalidCursor, v)
+		}
+		offset = o
+	}
+	return search.NewPage(limit, offset)
+}
+
+// ParseSort reads sort query parameter in {key} or -{key} (descending) format.
+func ParseSort(params url.Values, def search.Sort, allowed []search.SortKey) (search.Sort, error) {
+	v := params.Get(SortParam)
+	if v == "" {
+		return def, nil
+	}
+	desc := strings.HasPrefix(v, "-")
+	srt, err := search.NewSort(search.SortKey(strings.TrimPrefix(v, "-")), desc, allowed)
+	if err != nil {
+		return search.Sort{}, fmt.Errorf("%w: %w", ErrInvalidSort, err)
+	}
+	return srt, nil
+}
+
+// NextCursor returns cursor of the page following page, or empty string if it was the last one.
+func NextCursor(page search.Page, fetched, total int) string {
+	next, ok := page.Next(fetched, total)
+	if !ok {
+		return ""
+	}
+	return strconv.Itoa(next.Offset)
+}
+
+// NextPageQuery returns params with cursor moved to the page following page,
+// or empty string if it was the last one.
+func NextPageQuery(params url.Values, page search.Page, fetched, total int) string {
+	cursor := NextCursor(page, fetched, total)
+	if cursor == "" {
+		return ""
+	}
+	next := url.Values{}
+	for k, v := range params {
+		next[k] = v
+	}
+	next.Set(CursorParam, cursor)
+	return next.Encode()
+}
@@ -1,6 +1,7 @@
 package plantsquery
 
 import (
+	pagequery "PlantSite/internal/api-utils/query-filters/page-query"
 	"PlantSite/internal/models/search"
 	"fmt"
 	"sync"
@@ -26,6 +27,7 @@ func registryInit() {
 		registry.register(PlantSoilTypeFilterParam, parseSoilTypeFilterfunc)
 		registry.register(PlantWinterHardinessFilterParam, parsePlantWinterHardinessFilterfunc)
 		registry.register(PlantFloweringPeriodFilterParam, parsePlantFloweringPeriodFilterfunc)
+		registry.register(PlantTextFilterParam, parsePlantTextFilterfunc)
 	})
 }
 
@@ -39,7 +41,7 @@ func ParseGinQueryPlantSearch(c *gin.Context) (*search.PlantSearch, error) {
 	params := c.Request.URL.Query()
 	srch := search.NewPlantSearch()
 	for filterType, query := range params {
-		if len(query) == 0 {
+		if len(query) == 0 || pagequery.IsPageParam(filterType) {
 			continue
 		}
 		for _, q := range query {
@@ -50,5 +52,21 @@ func ParseGinQueryPlantSearch(c *gin.Context) (*search.PlantSearch, error) {
 			srch.AddFilter(filter)
 		}
 	}
+	page, err := pagequery.ParsePage(params)
+	if err != nil {
+		return &search.PlantSearch{}, fmt.Errorf("can't parse page in search: %w", err)
+	}
+	srch.SetPage(page)
+	defaultSort := srch.Sort()
+	if params.Get(string(PlantTextFilterParam)) != "" {
+		defaultSort = search.Sort{Key: search.SortByRelevance}
+	}
+	srt, err := pagequery.ParseSort(params, defaultSort, search.PlantSortKeys)
+	if err != nil {
+		return &search.PlantSearch{}, fmt.Errorf("can't parse sort in search: %w", err)
+	}
+	if err := srch.SetSort(srt); err != nil {
+		return &search.PlantSearch{}, fmt.Errorf("can't parse sort in search: %w", err)
+	}
 	return srch, nil
 }
@@ -187,3 +187,14 @@ func parsePlantFloweringPeriodFilterfunc(queryValue string) (search.PlantFilter,
 	}
 	return filt, nil
 }
+
+func parsePlantTextFilterfunc(queryValue string) (search.PlantFilter, error) {
+	if strings.TrimSpace(queryValue) == "" {
+		return nil, fmt.Errorf("%w: %v, %v", ErrParsingFailed, PlantTextFilterParam, queryValue)
+	}
+	filt := search.NewPlantTextFilter(queryValue)
+	if filt == nil {
+		return nil, fmt.Errorf("%w: %v, %v", ErrParsingFailed, PlantTextFilterParam, queryValue)
+	}
+	return filt, nil
+}
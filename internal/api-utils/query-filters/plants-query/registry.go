@@ -22,6 +22,7 @@ const (
 	PlantSoilTypeFilterParam        PlantFilterParam = "soil_type"
 	PlantWinterHardinessFilterParam PlantFilterParam = "winter_hardiness"
 	PlantFloweringPeriodFilterParam PlantFilterParam = "flowering_period"
+	PlantTextFilterParam            PlantFilterParam = "q"
 )
 
 type QueryPlantFilterRegistry struct {
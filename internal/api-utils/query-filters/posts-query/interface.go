@@ -1,6 +1,7 @@
 package postsquery
 
 import (
+	pagequery "PlantSite/internal/api-utils/query-filters/page-query"
 	"PlantSite/internal/models/search"
 	"fmt"
 	"sync"
@@ -18,6 +19,7 @@ func registryInit() {
 		registry.register(PostTitleFilterParam, parsePostTitleFilterfunc)
 		registry.register(PostTagsFilterParam, parsePostTagsFilterfunc)
 		registry.register(PostAuthorFilterParam, parsePostAuthorFilterfunc)
+		registry.register(PostTextFilterParam, parsePostTextFilterfunc)
 	})
 }
 
@@ -31,7 +33,7 @@ func ParseGinQueryPostSearch(c *gin.Context) (*search.PostSearch, error) {
 	params := c.Request.URL.Query()
 	srch := search.NewPostSearch()
 	for filterType, query := range params {
-		if len(query) == 0 {
+		if len(query) == 0 || pagequery.IsPageParam(filterType) {
 			continue
 		}
 		for _, q := range query {
@@ -42,5 +44,21 @@ func ParseGinQueryPostSearch(c *gin.Context) (*search.PostSearch, error) {
 			srch.AddFilter(filter)
 		}
 	}
+	page, err := pagequery.ParsePage(params)
+	if err != nil {
+		return &search.PostSearch{}, fmt.Errorf("can't parse page in search: %w", err)
+	}
+	srch.SetPage(page)
+	defaultSort := srch.Sort()
+	if params.Get(string(PostTextFilterParam)) != "" {
+		defaultSort = search.Sort{Key: search.SortByRelevance}
+	}
+	srt, err := pagequery.ParseSort(params, defaultSort, search.PostSortKeys)
+	if err != nil {
+		return &search.PostSearch{}, fmt.Errorf("can't parse sort in search: %w", err)
+	}
+	if err := srch.SetSort(srt); err != nil {
+		return &search.PostSearch{}, fmt.Errorf("can't parse sort in search: %w", err)
+	}
 	return srch, nil
 }
@@ -40,3 +40,14 @@ func parsePostAuthorFilterfunc(queryValue string) (search.PostFilter, error) {
 	}
 	return filt, nil
 }
+
+func parsePostTextFilterfunc(queryValue string) (search.PostFilter, error) {
+	if strings.TrimSpace(queryValue) == "" {
+		return nil, fmt.Errorf("%w: %v, %v", ErrParsingFailed, PostTextFilterParam, queryValue)
+	}
+	filt := search.NewPostTextFilter(queryValue)
+	if filt == nil {
+		return nil, fmt.Errorf("%w: %v, %v", ErrParsingFailed, PostTextFilterParam, queryValue)
+	}
+	return filt, nil
+}
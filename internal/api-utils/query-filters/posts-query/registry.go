@@ -13,6 +13,7 @@ const (
 	PostTitleFilterParam  PostFilterParam = "title"
 	PostTagsFilterParam   PostFilterParam = "tags"
 	PostAuthorFilterParam PostFilterParam = "author"
+	PostTextFilterParam   PostFilterParam = "q"
 )
 
 type QueryPostFilterRegistry struct {
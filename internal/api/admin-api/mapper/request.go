@@ -0,0 +1,39 @@
+package mapper
+
+import (
+	pagequery "PlantSite/internal/api-utils/query-filters/page-query"
+	"PlantSite/internal/api/admin-api/request"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func MapListMembersRequest(c *gin.Context) (*request.ListMembersRequest, error) {
+	page, err := pagequery.ParsePage(c.Request.URL.Query())
+	if err != nil {
+		return nil, err
+	}
+	return &request.ListMembersRequest{
+		Limit:  page.Limit,
+		Offset: page.Offset,
+	}, nil
+}
+
+type AuthorRightsRequest struct {
+	ID string `uri:"id" binding:"required"`
+}
+
+func MapAuthorRightsRequest(c *gin.Context) (*request.AuthorRightsRequest, error) {
+	var req AuthorRightsRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		return nil, fmt.Errorf("can't bind uri: %w", err)
+	}
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse id: %w", err)
+	}
+	return &request.AuthorRightsRequest{
+		ID: id,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package mapper
+
+import (
+	"PlantSite/internal/api/admin-api/response"
+	"PlantSite/internal/models/auth"
+	adminservice "PlantSite/internal/services/admin-service"
+)
+
+const timeFormat = "2006-01-02 15:04:05"
+
+func MapListMembersResponse(members []adminservice.MemberInfo) response.ListMembersResponse {
+	resp := make(response.ListMembersResponse, 0, len(members))
+	for _, m := range members {
+		resp = append(resp, response.Member{
+			ID:              m.ID.String(),
+			Name:            m.Name,
+			Email:           m.Email,
+			CreatedAt:       m.CreatedAt.Format(timeFormat),
+			IsAuthor:        m.IsAuthor,
+			HasAuthorRights: m.HasAuthorRights,
+		})
+	}
+	return resp
+}
+
+func MapAuthorRightsHistoryResponse(history []auth.AuthorRightsChange) response.AuthorRightsHistoryResponse {
+	resp := make(response.AuthorRightsHistoryResponse, 0, len(history))
+	for _, h := range history {
+		resp = append(resp, response.AuthorRightsChange{
+			HasRights: h.HasRights,
+			ChangedAt: h.ChangedAt.Format(timeFormat),
+		})
+	}
+	return resp
+}
@@ -0,0 +1,12 @@
+package request
+
+import "github.com/google/uuid"
+
+type ListMembersRequest struct {
+	Limit  int
+	Offset int
+}
+
+type AuthorRightsRequest struct {
+	ID uuid.UUID `uri:"id" binding:"required"`
+}
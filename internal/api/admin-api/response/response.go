@@ -0,0 +1,19 @@
+package response
+
+type Member struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Email           string `json:"email"`
+	CreatedAt       string `json:"created_at"`
+	IsAuthor        bool   `json:"is_author"`
+	HasAuthorRights bool   `json:"has_author_rights"`
+}
+
+type ListMembersResponse []Member
+
+type AuthorRightsChange struct {
+	HasRights bool   `json:"has_rights"`
+	ChangedAt string `json:"changed_at"`
+}
+
+type AuthorRightsHistoryResponse []AuthorRightsChange
@@ -0,0 +1,164 @@
+package adminapi
+
+import (
+	"PlantSite/internal/api/admin-api/mapper"
+	_ "PlantSite/internal/api/admin-api/request"
+	_ "PlantSite/internal/api/admin-api/response"
+	"PlantSite/internal/models/auth"
+	adminservice "PlantSite/internal/services/admin-service"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AdminRouter struct {
+	admin *adminservice.AdminService
+}
+
+func (r *AdminRouter) Init(router *gin.RouterGroup, admin *adminservice.AdminService) {
+	r.admin = admin
+	gr := router.Group("/admin")
+	gr.GET("/members", r.ListMembers)
+	gr.POST("/author/:id", r.GrantAuthorRights)
+	gr.DELETE("/author/:id", r.RevokeAuthorRights)
+	gr.GET("/author/history/:id", r.AuthorRightsHistory)
+}
+
+// List Members Handler
+// @Summary List members
+// @Description Lists registered members with their author rights
+// @Tags admin
+// @Produce json
+// @Param limit query int false "Page size"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Success 200 {object} response.ListMembersResponse "Members fetched successfully"
+// @Failure 400  "Bad Request - Invalid input"
+// @Failure 401  "Unauthorized - Not authorized to list members"
+// @Failure 403  "Forbidden - Does not have admin rights"
+// @Failure 500 "Internal Server Error - Failed to list members"
+// @Router /admin/members [get]
+func (r *AdminRouter) ListMembers(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, err := mapper.MapListMembersRequest(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+
+	members, err := r.admin.ListMembers(ctx, req.Offset, req.Limit)
+	if err != nil {
+		adminError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"members": mapper.MapListMembersResponse(members)})
+}
+
+// Grant Author Rights Handler
+// @Summary Grant author rights
+// @Description Makes the member an author or restores revoked author rights
+// @Tags admin
+// @Param id path string true "Member ID"
+// @Success 200  "Author rights granted successfully"
+// @Failure 400  "Bad Request - Invalid input or rights can't be changed"
+// @Failure 401  "Unauthorized - Not authorized to grant author rights"
+// @Failure 403  "Forbidden - Does not have admin rights"
+// @Failure 404  "Not Found - Member not found"
+// @Failure 500 "Internal Server Error - Failed to grant author rights"
+// @Router /admin/author/{id} [post]
+func (r *AdminRouter) GrantAuthorRights(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, err := mapper.MapAuthorRightsRequest(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+
+	err = r.admin.GrantAuthorRights(ctx, req.ID)
+	if err != nil {
+		adminError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+// Revoke Author Rights Handler
+// @Summary Revoke author rights
+// @Description Revokes author rights of the author
+// @Tags admin
+// @Param id path string true "Member ID"
+// @Success 200  "Author rights revoked successfully"
+// @Failure 400  "Bad Request - Invalid input, member is not an author or rights can't be changed"
+// @Failure 401  "Unauthorized - Not authorized to revoke author rights"
+// @Failure 403  "Forbidden - Does not have admin rights"
+// @Failure 404  "Not Found - Member not found"
+// @Failure 500 "Internal Server Error - Failed to revoke author rights"
+// @Router /admin/author/{id} [delete]
+func (r *AdminRouter) RevokeAuthorRights(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, err := mapper.MapAuthorRightsRequest(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+
+	err = r.admin.RevokeAuthorRights(ctx, req.ID)
+	if err != nil {
+		adminError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+// Author Rights History Handler
+// @Summary Get author rights history
+// @Description Lists changes of the member author rights in chronological order
+// @Tags admin
+// @Produce json
+// @Param id path string true "Member ID"
+// @Success 200 {object} response.AuthorRightsHistoryResponse "History fetched successfully"
+// @Failure 400  "Bad Request - Invalid input"
+// @Failure 401  "Unauthorized - Not authorized to get author rights history"
+// @Failure 403  "Forbidden - Does not have admin rights"
+// @Failure 404  "Not Found - Member not found"
+// @Failure 500 "Internal Server Error - Failed to get author rights history"
+// @Router /admin/author/history/{id} [get]
+func (r *AdminRouter) AuthorRightsHistory(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, err := mapper.MapAuthorRightsRequest(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+
+	history, err := r.admin.AuthorRightsHistory(ctx, req.ID)
+	if err != nil {
+		adminError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"history": mapper.MapAuthorRightsHistoryResponse(history)})
+}
+
+func adminError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, auth.ErrNotAuthorized):
+		status = http.StatusUnauthorized
+	case errors.Is(err, auth.ErrNoAdminRights):
+		status = http.StatusForbidden
+	case errors.Is(err, auth.ErrUserNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, adminservice.ErrNotAnAuthor), errors.Is(err, adminservice.ErrRightsNotEditable):
+		status = http.StatusBadRequest
+	}
+	c.JSON(status, gin.H{"error": err.Error()})
+	c.Error(err)
+}
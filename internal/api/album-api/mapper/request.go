@@ -2,6 +2,7 @@ package mapper
 
 import (
 	"PlantSite/internal/api/album-api/request"
+	"PlantSite/internal/models/album"
 	"fmt"
 
 	"github.com/gin-gonic/gin"
@@ -111,6 +112,47 @@ func MapUpdateAlbumDescriptionRequest(c *gin.Context) (*request.UpdateAlbumDescr
 	}, nil
 }
 
+type UpdateAlbumVisibilityRequest struct {
+	Visibility string `json:"visibility" form:"visibility" binding:"required"`
+}
+
+func MapUpdateAlbumVisibilityRequest(c *gin.Context) (*request.UpdateAlbumVisibilityRequest, error) {
+	req, err := fetchAlbumID(c)
+	if err != nil {
+		return nil, err
+	}
+	var reqVisibility UpdateAlbumVisibilityRequest
+	if err := c.ShouldBind(&reqVisibility); err != nil {
+		return nil, fmt.Errorf("can't bind visibility: %w", err)
+	}
+	visibility := album.Visibility(reqVisibility.Visibility)
+	if err := visibility.Validate(); err != nil {
+		return nil, err
+	}
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse id: %w", err)
+	}
+	return &request.UpdateAlbumVisibilityRequest{
+		ID:         id,
+		Visibility: visibility,
+	}, nil
+}
+
+type GetSharedAlbumRequest struct {
+	ShareToken string `uri:"token" binding:"required"`
+}
+
+func MapGetSharedAlbumRequest(c *gin.Context) (*request.GetSharedAlbumRequest, error) {
+	var req GetSharedAlbumRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		return nil, fmt.Errorf("can't bind uri: %w", err)
+	}
+	return &request.GetSharedAlbumRequest{
+		ShareToken: req.ShareToken,
+	}, nil
+}
+
 type AddPlantToAlbumRequest struct {
 	PlantID string `json:"plant_id" form:"plant_id" binding:"required"`
 }
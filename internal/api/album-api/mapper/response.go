@@ -20,6 +20,8 @@ func MapGetAlbumResponse(alb *album.Album) (*response.GetAlbumResponse, error) {
 		Name:        alb.Name(),
 		Description: alb.Description(),
 		PlantIDs:    plantIDs,
+		Visibility:  string(alb.Visibility()),
+		ShareToken:  alb.ShareToken(),
 		CreatedAt:   alb.CreatedAt().Format(timeFormat),
 		UpdatedAt:   alb.UpdatedAt().Format(timeFormat),
 	}, nil
@@ -40,6 +42,8 @@ func MapListAlbumsResponse(albs []*album.Album) (*response.ListAlbumsResponse, e
 			Name:        alb.Name(),
 			Description: alb.Description(),
 			PlantIDs:    plantIDs,
+			Visibility:  string(alb.Visibility()),
+			ShareToken:  alb.ShareToken(),
 			CreatedAt:   alb.CreatedAt().Format(timeFormat),
 			UpdatedAt:   alb.UpdatedAt().Format(timeFormat),
 		})
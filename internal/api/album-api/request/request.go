@@ -1,6 +1,10 @@
 package request
 
-import "github.com/google/uuid"
+import (
+	"PlantSite/internal/models/album"
+
+	"github.com/google/uuid"
+)
 
 type CreateAlbumRequest struct {
 	Name        string     `json:"name" form:"name" binding:"required"`
@@ -35,3 +39,12 @@ type RemovePlantFromAlbumRequest struct {
 type DeleteAlbumRequest struct {
 	ID uuid.UUID `uri:"id" binding:"required"`
 }
+
+type UpdateAlbumVisibilityRequest struct {
+	ID         uuid.UUID        `uri:"id" binding:"required"`
+	Visibility album.Visibility `json:"visibility" form:"visibility" binding:"required"`
+}
+
+type GetSharedAlbumRequest struct {
+	ShareToken string `uri:"token" binding:"required"`
+}
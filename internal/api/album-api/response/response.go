@@ -5,6 +5,8 @@ type GetAlbumResponse struct {
 	Name        string `json:"name" form:"name" binding:"required"`
 	Description string `json:"description" form:"description" binding:"required"`
 	PlantIDs    []string
+	Visibility  string `json:"visibility" form:"visibility" binding:"required"`
+	ShareToken  string `json:"share_token,omitempty" form:"share_token"`
 	CreatedAt   string `json:"created_at" form:"created_at" binding:"required"`
 	UpdatedAt   string `json:"updated_at" form:"updated_at" binding:"required"`
 }
@@ -14,6 +16,8 @@ type ListAlbum struct {
 	Name        string `json:"name" form:"name" binding:"required"`
 	Description string `json:"description" form:"description" binding:"required"`
 	PlantIDs    []string
+	Visibility  string `json:"visibility" form:"visibility" binding:"required"`
+	ShareToken  string `json:"share_token,omitempty" form:"share_token"`
 	CreatedAt   string `json:"created_at" form:"created_at" binding:"required"`
 	UpdatedAt   string `json:"updated_at" form:"updated_at" binding:"required"`
 }
@@ -25,6 +25,9 @@ func (r *AlbumRouter) Init(router *gin.RouterGroup, album *albumservice.AlbumSer
 	gr.GET("/get/:id", r.Get)
 	gr.PUT("/name/:id", r.UpdateName)
 	gr.PUT("/description/:id", r.UpdateDescription)
+	gr.PUT("/visibility/:id", r.UpdateVisibility)
+	gr.GET("/shared/:token", r.GetShared)
+	gr.GET("/public", r.ListPublic)
 	gr.POST("/add/:id", r.AddPlantToAlbum)
 	gr.DELETE("/remove/:id", r.RemovePlantFromAlbum)
 	gr.DELETE("/delete/:id", r.Delete)
@@ -40,6 +43,7 @@ func (r *AlbumRouter) Init(router *gin.RouterGroup, album *albumservice.AlbumSer
 // @Success 200  "Album created successfully"
 // @Failure 400  "Bad Request - Invalid input or missing required fields"
 // @Failure 401  "Unauthorized - Not authorized to create album"
+// @Failure 403  "Forbidden - Email is not verified"
 // @Failure 500 "Internal Server Error - Failed to create album"
 // @Router /album/create [post]
 func (r *AlbumRouter) Create(c *gin.Context) {
@@ -65,6 +69,10 @@ func (r *AlbumRouter) Create(c *gin.Context) {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 		c.Error(err)
 		return
+	} else if errors.Is(err, auth.ErrEmailNotVerified) {
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
 	} else if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		c.Error(err)
@@ -75,7 +83,7 @@ func (r *AlbumRouter) Create(c *gin.Context) {
 
 // Get Album Handler
 // @Summary Get album
-// @Description Gets an album by ID
+// @Description Gets an album by ID. Public albums are read by anyone, other albums only by the owner
 // @Tags album
 // @Produce json
 // @Param id path string true "Album ID"
@@ -184,6 +192,127 @@ func (r *AlbumRouter) UpdateDescription(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{})
 }
 
+// Update Album Visibility Handler
+// @Summary Update album visibility
+// @Description Makes an album private, unlisted or public. An unlisted album gets a new share link, other visibilities revoke it
+// @Tags album
+// @Accept json
+// @Produce json
+// @Param id path string true "Album ID"
+// @Param request body mapper.UpdateAlbumVisibilityRequest true "Update album visibility request body"
+// @Success 200  {object} response.GetAlbumResponse "Album visibility updated successfully"
+// @Failure 400  "Bad Request - Invalid input or unknown visibility"
+// @Failure 401  "Unauthorized - Not authorized to update album visibility"
+// @Failure 403  "Forbidden - Not owner of the album"
+// @Failure 404  "Not Found - Album not found"
+// @Failure 500 "Internal Server Error - Failed to update album visibility"
+// @Router /album/visibility/{id} [put]
+func (r *AlbumRouter) UpdateVisibility(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, err := mapper.MapUpdateAlbumVisibilityRequest(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+
+	alb, err := r.album.UpdateAlbumVisibility(ctx, req.ID, req.Visibility)
+	if errors.Is(err, auth.ErrNotAuthorized) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	} else if errors.Is(err, albumservice.ErrNotOwner) {
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	} else if errors.Is(err, album.ErrAlbumNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+	resp, err := mapper.MapGetAlbumResponse(alb)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"album": resp})
+}
+
+// Get Shared Album Handler
+// @Summary Get shared album
+// @Description Gets an unlisted album by its share link token, no login is needed
+// @Tags album
+// @Produce json
+// @Param token path string true "Share token"
+// @Success 200  {object} response.GetAlbumResponse "Album fetch successfully"
+// @Failure 400  "Bad Request - Invalid input"
+// @Failure 404  "Not Found - No album is shared with the token"
+// @Failure 500 "Internal Server Error - Failed to get album"
+// @Router /album/shared/{token} [get]
+func (r *AlbumRouter) GetShared(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, err := mapper.MapGetSharedAlbumRequest(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+
+	alb, err := r.album.GetSharedAlbum(ctx, req.ShareToken)
+	if errors.Is(err, album.ErrAlbumNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+	resp, err := mapper.MapGetAlbumResponse(alb)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"album": resp})
+}
+
+// List Public Albums Handler
+// @Summary List public albums
+// @Description Lists public albums of all users, recently updated first, no login is needed
+// @Tags album
+// @Produce json
+// @Success 200  {object} response.ListAlbumsResponse "Albums fetch successfully"
+// @Failure 500 "Internal Server Error - Failed to list albums"
+// @Router /album/public [get]
+func (r *AlbumRouter) ListPublic(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	albs, err := r.album.ListPublicAlbums(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+
+	resp, err := mapper.MapListAlbumsResponse(albs)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"albums": resp})
+}
+
 // Add Plant to Album Handler
 // @Summary Add plant to album
 // @Description Adds a plant to an album
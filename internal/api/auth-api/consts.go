@@ -1,5 +1,38 @@
 package authapi
 
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
 const (
 	SessionCookieName = "pp-session"
 )
+
+type CookieConfig struct {
+	Secure   bool
+	SameSite http.SameSite
+	Domain   string
+}
+
+// SessionCookie configures the session cookie, Secure should be set when the site is served over HTTPS.
+var SessionCookie = CookieConfig{
+	Secure:   false,
+	SameSite: http.SameSiteLaxMode,
+	Domain:   "",
+}
+
+func UpdateSessionCookie(cfg CookieConfig) {
+	if cfg.SameSite == http.SameSiteNoneMode && !cfg.Secure {
+		panic("SameSite=None session cookie must be secure")
+	}
+	SessionCookie = cfg
+}
+
+// SetSessionCookie writes the session cookie, maxAge 0 makes it last until the browser is closed
+// and negative maxAge deletes it.
+func SetSessionCookie(c *gin.Context, value string, maxAge int) {
+	c.SetSameSite(SessionCookie.SameSite)
+	c.SetCookie(SessionCookieName, value, maxAge, "/", SessionCookie.Domain, SessionCookie.Secure, true)
+}
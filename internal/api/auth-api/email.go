@@ -0,0 +1,80 @@
+package authapi
+
+import (
+	authservice "PlantSite/internal/services/auth-service"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EmailVerificationRouter struct {
+	verification *authservice.EmailVerificationService
+}
+
+func (r *EmailVerificationRouter) Init(router *gin.RouterGroup, verification *authservice.EmailVerificationService) {
+	r.verification = verification
+	gr := router.Group("/auth/email")
+	gr.POST("/verify", r.Verify)
+	gr.POST("/resend", r.Resend)
+}
+
+// Verify Email Handler
+// @Summary Verify email
+// @Description Confirms the member email by the token from the verification link. The token can be used only once
+// @Tags auth
+// @Accept json
+// @Accept mpfd
+// @Param request body VerifyEmailRequest true "Verification token"
+// @Success 200 "Email verified"
+// @Failure 400 "Wrong input parameters or invalid token"
+// @Failure 500 "Failed to verify email"
+// @Router /auth/email/verify [post]
+func (r *EmailVerificationRouter) Verify(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req VerifyEmailRequest
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	err := r.verification.VerifyEmail(ctx, req.Token)
+	if errors.Is(err, authservice.ErrInvalidToken) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+// Resend Verification Handler
+// @Summary Resend verification link
+// @Description Mails a new verification link to the member with the email. Succeeds for unknown and verified emails too
+// @Tags auth
+// @Accept json
+// @Accept mpfd
+// @Param request body ResendVerificationRequest true "Member email"
+// @Success 200 "Verification link sent if the email awaits verification"
+// @Failure 400 "Wrong input parameters"
+// @Failure 500 "Failed to send verification link"
+// @Router /auth/email/resend [post]
+func (r *EmailVerificationRouter) Resend(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req ResendVerificationRequest
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	err := r.verification.SendVerification(ctx, req.Email)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
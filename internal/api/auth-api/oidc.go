@@ -0,0 +1,93 @@
+package authapi
+
+import (
+	authservice "PlantSite/internal/services/auth-service"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	OIDCLoginCookieName = "pp-oidc-login"
+	// oidcLoginMaxAge is how long the user has to sign in at the identity provider.
+	oidcLoginMaxAge = 10 * 60
+)
+
+type OIDCRouter struct {
+	oidc *authservice.OIDCService
+}
+
+func (r *OIDCRouter) Init(router *gin.RouterGroup, oidc *authservice.OIDCService) {
+	r.oidc = oidc
+	gr := router.Group("/auth/oidc")
+	gr.GET("/login", r.Login)
+	gr.GET("/callback", r.Callback)
+}
+
+// setOIDCLoginCookie keeps the login in the browser until the provider redirects back.
+// The redirect is a cross-site navigation, so the cookie is always SameSite=Lax.
+func setOIDCLoginCookie(c *gin.Context, value string, maxAge int) {
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(OIDCLoginCookieName, value, maxAge, "/", SessionCookie.Domain, SessionCookie.Secure, true)
+}
+
+// OIDC Login Handler
+// @Summary Login with the identity provider
+// @Description Redirects to the identity provider to sign in, the provider redirects back to the callback
+// @Tags auth
+// @Success 302 "Redirect to the identity provider"
+// @Failure 500 "Failed to start the login"
+// @Router /auth/oidc/login [get]
+func (r *OIDCRouter) Login(c *gin.Context) {
+	login, authURL, err := r.oidc.Begin()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+	setOIDCLoginCookie(c, strings.Join([]string{login.State, login.Nonce, login.CodeVerifier}, "."), oidcLoginMaxAge)
+	c.Redirect(http.StatusFound, authURL)
+}
+
+// OIDC Callback Handler
+// @Summary Identity provider callback
+// @Description Completes the login with the identity provider and creates a session. Unknown accounts are linked to the member with the same verified email or get a new member
+// @Tags auth
+// @Param code query string true "Authorization code"
+// @Param state query string true "Login state"
+// @Success 302 "Session for user created, redirect to the site"
+// @Failure 401 "Login failed"
+// @Failure 409 "Email belongs to another member"
+// @Router /auth/oidc/callback [get]
+func (r *OIDCRouter) Callback(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var login *authservice.OIDCLogin
+	if value, err := c.Cookie(OIDCLoginCookieName); err == nil {
+		if parts := strings.Split(value, "."); len(parts) == 3 {
+			login = &authservice.OIDCLogin{State: parts[0], Nonce: parts[1], CodeVerifier: parts[2]}
+		}
+	}
+	// The login can be completed only once
+	setOIDCLoginCookie(c, "", -1)
+
+	if providerErr := c.Query("error"); providerErr != "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": providerErr})
+		return
+	}
+
+	sessID, err := r.oidc.Complete(ctx, login, c.Query("state"), c.Query("code"))
+	if errors.Is(err, authservice.ErrEmailTaken) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	} else if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+	SetSessionCookie(c, sessID.String(), 0)
+	c.Redirect(http.StatusFound, "/view")
+}
@@ -0,0 +1,81 @@
+package authapi
+
+import (
+	authservice "PlantSite/internal/services/auth-service"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PasswordResetRouter struct {
+	reset *authservice.PasswordResetService
+}
+
+func (r *PasswordResetRouter) Init(router *gin.RouterGroup, reset *authservice.PasswordResetService) {
+	r.reset = reset
+	gr := router.Group("/auth/password")
+	gr.POST("/forgot", r.Forgot)
+	gr.POST("/reset", r.Reset)
+}
+
+// Forgot Password Handler
+// @Summary Request password reset
+// @Description Mails a password reset link to the member with the email. Succeeds for unknown emails too
+// @Tags auth
+// @Accept json
+// @Accept mpfd
+// @Param request body ForgotPasswordRequest true "Member email"
+// @Success 200 "Reset link sent if the email is registered"
+// @Failure 400 "Wrong input parameters"
+// @Failure 500 "Failed to send reset link"
+// @Router /auth/password/forgot [post]
+func (r *PasswordResetRouter) Forgot(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req ForgotPasswordRequest
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	err := r.reset.RequestPasswordReset(ctx, req.Email)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+// Reset Password Handler
+// @Summary Reset password
+// @Description Sets a new password by the token from the reset link. The token can be used only once
+// @Tags auth
+// @Accept json
+// @Accept mpfd
+// @Param request body ResetPasswordRequest true "Reset token and new password"
+// @Success 200 "Password changed"
+// @Failure 400 "Wrong input parameters, invalid token or weak password"
+// @Failure 500 "Failed to change password"
+// @Router /auth/password/reset [post]
+func (r *PasswordResetRouter) Reset(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req ResetPasswordRequest
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	err := r.reset.ResetPassword(ctx, req.Token, req.Password)
+	if errors.Is(err, authservice.ErrInvalidToken) || errors.Is(err, authservice.ErrPasswordNotResettable) ||
+		errors.Is(err, authservice.ErrWeakPassword) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
@@ -0,0 +1,147 @@
+package authapi
+
+import (
+	"PlantSite/internal/models/auth"
+	authservice "PlantSite/internal/services/auth-service"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ProfileRouter struct {
+	auth         *authservice.AuthService
+	verification *authservice.EmailVerificationService
+}
+
+func (r *ProfileRouter) Init(router *gin.RouterGroup, auth *authservice.AuthService, verification *authservice.EmailVerificationService) {
+	r.auth = auth
+	r.verification = verification
+	gr := router.Group("/auth/profile")
+	gr.GET("", r.Get)
+	gr.PUT("/name", r.ChangeName)
+	gr.PUT("/email", r.ChangeEmail)
+	gr.PUT("/password", r.ChangePassword)
+}
+
+func profileError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, auth.ErrNotAuthorized):
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+	case errors.Is(err, authservice.ErrSessionRequired), errors.Is(err, authservice.ErrProfileNotEditable):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, authservice.ErrNameTaken), errors.Is(err, authservice.ErrEmailTaken):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, authservice.ErrInvalidCredentials), errors.Is(err, authservice.ErrWeakPassword):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+	c.Error(err)
+}
+
+// Get Profile Handler
+// @Summary Get profile
+// @Description Returns the profile of the logged in user
+// @Tags auth
+// @Produce json
+// @Success 200 {object} ProfileResponse "Profile"
+// @Failure 401 "Not authorized"
+// @Router /auth/profile [get]
+func (r *ProfileRouter) Get(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	user := r.auth.UserFromContext(ctx)
+	if !user.IsAuthenticated() {
+		profileError(c, auth.ErrNotAuthorized)
+		return
+	}
+	c.JSON(http.StatusOK, mapProfileResponse(user))
+}
+
+// Change Name Handler
+// @Summary Change name
+// @Description Renames the logged in user
+// @Tags auth
+// @Accept json
+// @Accept mpfd
+// @Param request body ChangeNameRequest true "New name"
+// @Success 200 "Name changed"
+// @Failure 400 "Wrong input parameters"
+// @Failure 401 "Not authorized"
+// @Failure 403 "Profile can't be changed"
+// @Failure 409 "Name is taken"
+// @Router /auth/profile/name [put]
+func (r *ProfileRouter) ChangeName(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req ChangeNameRequest
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := r.auth.ChangeName(ctx, req.Name); err != nil {
+		profileError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+// Change Email Handler
+// @Summary Change email
+// @Description Changes the email of the logged in user and mails a verification link to the new email. Requires the current password
+// @Tags auth
+// @Accept json
+// @Accept mpfd
+// @Param request body ChangeEmailRequest true "Current password and new email"
+// @Success 200 "Email changed"
+// @Failure 400 "Wrong input parameters or wrong current password"
+// @Failure 401 "Not authorized"
+// @Failure 403 "Profile can't be changed"
+// @Failure 409 "Email is taken"
+// @Router /auth/profile/email [put]
+func (r *ProfileRouter) ChangeEmail(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req ChangeEmailRequest
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := r.auth.ChangeEmail(ctx, req.CurrentPassword, req.Email); err != nil {
+		profileError(c, err)
+		return
+	}
+	// The email is changed anyway, the link can be requested again
+	if err := r.verification.SendVerification(ctx, req.Email); err != nil {
+		c.Error(err)
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+// Change Password Handler
+// @Summary Change password
+// @Description Changes the password of the logged in user. Requires the current password, other sessions of the user are ended
+// @Tags auth
+// @Accept json
+// @Accept mpfd
+// @Param request body ChangePasswordRequest true "Current and new password"
+// @Success 200 "Password changed"
+// @Failure 400 "Wrong input parameters, wrong current password or weak new password"
+// @Failure 401 "Not authorized"
+// @Failure 403 "Profile can't be changed"
+// @Router /auth/profile/password [put]
+func (r *ProfileRouter) ChangePassword(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req ChangePasswordRequest
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := r.auth.ChangePassword(ctx, req.CurrentPassword, req.NewPassword); err != nil {
+		profileError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
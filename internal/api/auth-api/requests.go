@@ -3,6 +3,7 @@ package authapi
 type LoginRequest struct {
 	Username string `json:"username" form:"username" binding:"required"`
 	Password string `json:"password" form:"password" binding:"required"`
+	Remember bool   `json:"remember" form:"remember"`
 }
 
 type RegisterRequest struct {
@@ -12,3 +13,39 @@ type RegisterRequest struct {
 }
 
 type LogoutRequest struct{}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" form:"email" binding:"required,email"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token" form:"token" binding:"required"`
+	Password string `json:"password" form:"password" binding:"required"`
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token" form:"token" binding:"required"`
+}
+
+type ResendVerificationRequest struct {
+	Email string `json:"email" form:"email" binding:"required,email"`
+}
+
+type CreateAPITokenRequest struct {
+	Name  string `json:"name" form:"name" binding:"required"`
+	Scope string `json:"scope" form:"scope" binding:"required"`
+}
+
+type ChangeNameRequest struct {
+	Name string `json:"name" form:"name" binding:"required,max=32"`
+}
+
+type ChangeEmailRequest struct {
+	CurrentPassword string `json:"current_password" form:"current_password" binding:"required"`
+	Email           string `json:"email" form:"email" binding:"required,email"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" form:"current_password" binding:"required"`
+	NewPassword     string `json:"new_password" form:"new_password" binding:"required"`
+}
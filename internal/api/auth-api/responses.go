@@ -1 +1,74 @@
 package authapi
+
+import (
+	"PlantSite/internal/models/auth"
+	authservice "PlantSite/internal/services/auth-service"
+
+	"github.com/google/uuid"
+)
+
+const timeFormat = "2006-01-02 15:04:05"
+
+type APITokenResponse struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Scope     string `json:"scope"`
+	CreatedAt string `json:"created_at"`
+}
+
+type CreatedAPITokenResponse struct {
+	APITokenResponse
+	// Token is shown only once, it can't be restored later
+	Token string `json:"token"`
+}
+
+func mapAPITokenResponse(token *authservice.APIToken) APITokenResponse {
+	return APITokenResponse{
+		ID:        token.ID.String(),
+		Name:      token.Name,
+		Scope:     string(token.Scope),
+		CreatedAt: token.CreatedAt.Format(timeFormat),
+	}
+}
+
+type ProfileResponse struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+}
+
+func mapProfileResponse(user auth.User) ProfileResponse {
+	resp := ProfileResponse{
+		ID:            user.ID().String(),
+		Name:          user.Username(),
+		EmailVerified: auth.IsEmailVerified(user),
+	}
+	if withEmail, ok := user.(interface{ Email() string }); ok {
+		resp.Email = withEmail.Email()
+	}
+	return resp
+}
+
+type SessionResponse struct {
+	ID         string `json:"id"`
+	UserAgent  string `json:"user_agent"`
+	IP         string `json:"ip"`
+	CreatedAt  string `json:"created_at"`
+	LastSeenAt string `json:"last_seen_at"`
+	ExpiresAt  string `json:"expires_at"`
+	// Current marks the session of the request
+	Current bool `json:"current"`
+}
+
+func mapSessionResponse(session *authservice.Session, current uuid.UUID) SessionResponse {
+	return SessionResponse{
+		ID:         session.ID.String(),
+		UserAgent:  session.UserAgent,
+		IP:         session.IP,
+		CreatedAt:  session.CreatedAt.Format(timeFormat),
+		LastSeenAt: session.LastSeenAt.Format(timeFormat),
+		ExpiresAt:  session.ExpiresAt.Format(timeFormat),
+		Current:    session.ID == current,
+	}
+}
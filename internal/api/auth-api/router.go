@@ -2,17 +2,24 @@ package authapi
 
 import (
 	authservice "PlantSite/internal/services/auth-service"
+	"errors"
+	"math"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 )
 
 type AuthRouter struct {
-	auth *authservice.AuthService
+	auth         *authservice.AuthService
+	verification *authservice.EmailVerificationService
+	limiter      *authservice.LoginLimiter
 }
 
-func (r *AuthRouter) Init(router *gin.RouterGroup, auth *authservice.AuthService) {
+func (r *AuthRouter) Init(router *gin.RouterGroup, auth *authservice.AuthService, verification *authservice.EmailVerificationService, limiter *authservice.LoginLimiter) {
 	r.auth = auth
+	r.verification = verification
+	r.limiter = limiter
 	gr := router.Group("/auth")
 	gr.POST("/login", r.Login)
 	gr.POST("/register", r.Register)
@@ -21,7 +28,7 @@ func (r *AuthRouter) Init(router *gin.RouterGroup, auth *authservice.AuthService
 
 // Login Handler
 // @Summary User login
-// @Description Authenticates a user and creates a session
+// @Description Authenticates a user and creates a session. Remembered sessions outlive browser restarts
 // @Tags auth
 // @Accept json
 // @Accept mpfd
@@ -29,6 +36,7 @@ func (r *AuthRouter) Init(router *gin.RouterGroup, auth *authservice.AuthService
 // @Success 200 "Session for user created"
 // @Failure 400 "Wrong input parameters"
 // @Failure 401 "Auth error"
+// @Failure 429 "Too many failed attempts, retry after the Retry-After seconds"
 // @Router /auth/login [post]
 func (r *AuthRouter) Login(c *gin.Context) {
 	ctx := c.Request.Context()
@@ -38,24 +46,34 @@ func (r *AuthRouter) Login(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
-	sessID, err := r.auth.Login(ctx, req.Username, req.Password)
-	if err != nil {
+	sessID, err := r.limiter.Login(ctx, req.Username, req.Password, c.ClientIP(), req.Remember)
+	var throttled *authservice.LoginThrottledError
+	if errors.As(err, &throttled) {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(throttled.RetryAfter.Seconds()))))
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	} else if err != nil {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 		return
 	}
-	c.SetCookie(SessionCookieName, sessID.String(), 0, "/", "", false, true)
+	maxAge := 0
+	if req.Remember {
+		maxAge = int(authservice.RememberSessionMaxLifetime.Seconds())
+	}
+	SetSessionCookie(c, sessID.String(), maxAge)
 	c.JSON(http.StatusOK, gin.H{})
 }
 
 // Register Handler
 // @Summary Register a new user
-// @Description Registers a new user. Requires login afterwards
+// @Description Registers a new user and mails an email verification link. Requires login afterwards
 // @Tags auth
 // @Accept json
 // @Accept mpfd
 // @Param request body RegisterRequest true "Register credentials"
 // @Success 200 "User registered"
-// @Failure 400 "Wrong input parameters"
+// @Failure 400 "Wrong input parameters or weak password"
 // @Failure 401 "Auth error"
 // @Router /auth/register [post]
 func (r *AuthRouter) Register(c *gin.Context) {
@@ -67,10 +85,17 @@ func (r *AuthRouter) Register(c *gin.Context) {
 		return
 	}
 	err := r.auth.Register(ctx, req.Username, req.Email, req.Password)
-	if err != nil {
+	if errors.Is(err, authservice.ErrWeakPassword) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	} else if err != nil {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 		return
 	}
+	// The member is registered anyway, the link can be requested again
+	if err := r.verification.SendVerification(ctx, req.Email); err != nil {
+		c.Error(err)
+	}
 	c.JSON(http.StatusOK, gin.H{})
 }
 
@@ -89,5 +114,6 @@ func (r *AuthRouter) Logout(c *gin.Context) {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 		return
 	}
+	SetSessionCookie(c, "", -1)
 	c.JSON(http.StatusOK, gin.H{})
 }
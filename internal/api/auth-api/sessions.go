@@ -0,0 +1,112 @@
+package authapi
+
+import (
+	"PlantSite/internal/models/auth"
+	authservice "PlantSite/internal/services/auth-service"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type SessionRouter struct {
+	auth *authservice.AuthService
+}
+
+func (r *SessionRouter) Init(router *gin.RouterGroup, auth *authservice.AuthService) {
+	r.auth = auth
+	gr := router.Group("/auth/sessions")
+	gr.GET("", r.List)
+	gr.DELETE("", r.RevokeOthers)
+	gr.DELETE("/:id", r.Revoke)
+}
+
+func sessionError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, auth.ErrNotAuthorized):
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+	case errors.Is(err, authservice.ErrSessionRequired):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, authservice.ErrSessionNotFound), errors.Is(err, authservice.ErrSessionExpired):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+	c.Error(err)
+}
+
+// List Sessions Handler
+// @Summary List sessions
+// @Description Lists active sessions of the logged in user, most recently used first. The session of the request is marked as current
+// @Tags auth
+// @Produce json
+// @Success 200 {array} SessionResponse "Sessions"
+// @Failure 401 "Not authorized"
+// @Failure 403 "Sessions can't be managed with a token"
+// @Failure 500 "Failed to list sessions"
+// @Router /auth/sessions [get]
+func (r *SessionRouter) List(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	sessions, err := r.auth.ListSessions(ctx)
+	if err != nil {
+		sessionError(c, err)
+		return
+	}
+	current := r.auth.CurrentSessionID(ctx)
+	resp := make([]SessionResponse, 0, len(sessions))
+	for _, session := range sessions {
+		resp = append(resp, mapSessionResponse(session, current))
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// Revoke Session Handler
+// @Summary Revoke session
+// @Description Ends a session of the logged in user. Revoking the current session logs out
+// @Tags auth
+// @Param id path string true "Session ID"
+// @Success 200 "Session revoked"
+// @Failure 400 "Wrong session ID"
+// @Failure 401 "Not authorized"
+// @Failure 403 "Sessions can't be managed with a token"
+// @Failure 404 "Session not found"
+// @Failure 500 "Failed to revoke session"
+// @Router /auth/sessions/{id} [delete]
+func (r *SessionRouter) Revoke(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := r.auth.RevokeSession(ctx, id); err != nil {
+		sessionError(c, err)
+		return
+	}
+	if id == r.auth.CurrentSessionID(ctx) {
+		SetSessionCookie(c, "", -1)
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+// Revoke Other Sessions Handler
+// @Summary Revoke other sessions
+// @Description Ends all sessions of the logged in user but the current one
+// @Tags auth
+// @Success 200 "Sessions revoked"
+// @Failure 401 "Not authorized"
+// @Failure 403 "Sessions can't be managed with a token"
+// @Failure 500 "Failed to revoke sessions"
+// @Router /auth/sessions [delete]
+func (r *SessionRouter) RevokeOthers(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	if err := r.auth.RevokeOtherSessions(ctx); err != nil {
+		sessionError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
@@ -0,0 +1,127 @@
+package authapi
+
+import (
+	"PlantSite/internal/models/auth"
+	authservice "PlantSite/internal/services/auth-service"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type APITokenRouter struct {
+	tokens *authservice.APITokenService
+}
+
+func (r *APITokenRouter) Init(router *gin.RouterGroup, tokens *authservice.APITokenService) {
+	r.tokens = tokens
+	gr := router.Group("/auth/tokens")
+	gr.GET("", r.List)
+	gr.POST("", r.Create)
+	gr.DELETE("/:id", r.Revoke)
+}
+
+func apiTokenError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, auth.ErrNotAuthorized):
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+	case errors.Is(err, auth.ErrNoAdminRights), errors.Is(err, authservice.ErrSessionRequired):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, authservice.ErrAPITokenNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+	c.Error(err)
+}
+
+// List API Tokens Handler
+// @Summary List personal API tokens
+// @Description Lists API tokens of the logged in user, the tokens themselves are not shown
+// @Tags auth
+// @Produce json
+// @Success 200 {array} APITokenResponse "API tokens"
+// @Failure 401 "Not authorized"
+// @Failure 403 "Tokens can't be managed with a token"
+// @Failure 500 "Failed to list tokens"
+// @Router /auth/tokens [get]
+func (r *APITokenRouter) List(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	tokens, err := r.tokens.List(ctx)
+	if err != nil {
+		apiTokenError(c, err)
+		return
+	}
+	resp := make([]APITokenResponse, 0, len(tokens))
+	for _, token := range tokens {
+		resp = append(resp, mapAPITokenResponse(token))
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// Create API Token Handler
+// @Summary Create personal API token
+// @Description Creates an API token for Bearer authentication. Scope is read-only, author or admin. The token is shown only in this response
+// @Tags auth
+// @Accept json
+// @Accept mpfd
+// @Produce json
+// @Param request body CreateAPITokenRequest true "Token name and scope"
+// @Success 200 {object} CreatedAPITokenResponse "Created token"
+// @Failure 400 "Wrong input parameters"
+// @Failure 401 "Not authorized"
+// @Failure 403 "Scope is not allowed for the user"
+// @Failure 500 "Failed to create token"
+// @Router /auth/tokens [post]
+func (r *APITokenRouter) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req CreateAPITokenRequest
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	scope, err := auth.ParseTokenScope(req.Scope)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	apiToken, token, err := r.tokens.Create(ctx, req.Name, scope)
+	if err != nil {
+		apiTokenError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, CreatedAPITokenResponse{
+		APITokenResponse: mapAPITokenResponse(apiToken),
+		Token:            token,
+	})
+}
+
+// Revoke API Token Handler
+// @Summary Revoke personal API token
+// @Description Revokes an API token of the logged in user
+// @Tags auth
+// @Param id path string true "Token ID"
+// @Success 200 "Token revoked"
+// @Failure 400 "Wrong token ID"
+// @Failure 401 "Not authorized"
+// @Failure 403 "Tokens can't be managed with a token"
+// @Failure 404 "Token not found"
+// @Failure 500 "Failed to revoke token"
+// @Router /auth/tokens/{id} [delete]
+func (r *APITokenRouter) Revoke(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := r.tokens.Revoke(ctx, id); err != nil {
+		apiTokenError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
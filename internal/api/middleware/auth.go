@@ -4,21 +4,43 @@ import (
 	authapi "PlantSite/internal/api/auth-api"
 	"PlantSite/internal/models/auth"
 	authservice "PlantSite/internal/services/auth-service"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
 
-func AuthMiddleware(s *authservice.AuthService) gin.HandlerFunc {
+const bearerPrefix = "Bearer "
+
+func bearerToken(c *gin.Context) (string, bool) {
+	header := c.GetHeader("Authorization")
+	if len(header) <= len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+		return "", false
+	}
+	return strings.TrimSpace(header[len(bearerPrefix):]), true
+}
+
+// AuthMiddleware authenticates the request by the Authorization: Bearer token if it is present,
+// otherwise by the session cookie.
+func AuthMiddleware(s *authservice.AuthService, tokens *authservice.APITokenService) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		var sessID uuid.UUID = uuid.Nil
-		if cookie, err := c.Request.Cookie(authapi.SessionCookieName); err == nil {
-			sessID, err = uuid.Parse(cookie.Value)
-			if err != nil {
-				sessID = uuid.Nil
+		ctx := authservice.WithClient(c.Request.Context(), authservice.Client{
+			UserAgent: c.Request.UserAgent(),
+			IP:        c.ClientIP(),
+		})
+		token, bearer := bearerToken(c)
+		if bearer {
+			ctx = tokens.Authenticate(ctx, token)
+		} else {
+			var sessID uuid.UUID = uuid.Nil
+			if cookie, err := c.Request.Cookie(authapi.SessionCookieName); err == nil {
+				sessID, err = uuid.Parse(cookie.Value)
+				if err != nil {
+					sessID = uuid.Nil
+				}
 			}
+			ctx = s.Authenticate(ctx, sessID)
 		}
-		ctx := s.Authenticate(c.Request.Context(), sessID)
 		c.Request = c.Request.WithContext(ctx)
 
 		l, ex := c.Get(LoggerKey)
@@ -26,14 +48,14 @@ func AuthMiddleware(s *authservice.AuthService) gin.HandlerFunc {
 
 		if ex {
 			if _, ok := user.(*auth.NoAuthUser); ok {
-				l.(MiddlewareLogger).Infow("request unauthenticated", "request_id", c.GetString(RequestIDKey))
+				l.(MiddlewareLogger).Infow("request unauthenticated", "request_id", c.GetString(RequestIDKey), "bearer", bearer)
 			} else {
-				l.(MiddlewareLogger).Infow("request authenticated", "request_id", c.GetString(RequestIDKey), "user_id", user.ID())
+				l.(MiddlewareLogger).Infow("request authenticated", "request_id", c.GetString(RequestIDKey), "user_id", user.ID(), "bearer", bearer)
 			}
 		}
 
-		if _, ok := user.(*auth.NoAuthUser); ok {
-			c.SetCookie(authapi.SessionCookieName, "", -1, "/", "", false, false)
+		if _, ok := user.(*auth.NoAuthUser); ok && !bearer {
+			authapi.SetSessionCookie(c, "", -1)
 		}
 
 		c.Next()
@@ -176,3 +176,135 @@ func MapUploadPlantPhotoRequest(c *gin.Context) (*request.UploadPlantPhotoReques
 		Description: req.Description,
 	}, nil
 }
+
+type UpdatePlantRequestID struct {
+	ID string `uri:"id" binding:"required"`
+}
+
+type UpdatePlantRequestBody struct {
+	Name        *string `json:"name" form:"name" binding:"omitempty,min=1"`
+	LatinName   *string `json:"latin_name" form:"latin_name" binding:"omitempty,min=1"`
+	Description *string `json:"description" form:"description" binding:"omitempty,min=1"`
+}
+
+func MapUpdatePlantRequest(c *gin.Context) (*request.UpdatePlantRequest, error) {
+	var reqID UpdatePlantRequestID
+	if err := c.ShouldBindUri(&reqID); err != nil {
+		return nil, fmt.Errorf("can't bind uri: %w", err)
+	}
+	id, err := uuid.Parse(reqID.ID)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse id: %w", err)
+	}
+	var req UpdatePlantRequestBody
+	if err := c.ShouldBind(&req); err != nil {
+		return nil, fmt.Errorf("can't bind body: %w", err)
+	}
+	if req.Name == nil && req.LatinName == nil && req.Description == nil {
+		return nil, fmt.Errorf("nothing to update")
+	}
+	return &request.UpdatePlantRequest{
+		ID:          id,
+		Name:        req.Name,
+		LatinName:   req.LatinName,
+		Description: req.Description,
+	}, nil
+}
+
+func MapSetMainPhotoRequest(c *gin.Context) (*request.SetMainPhotoRequest, error) {
+	id, photoID, err := mapPlantPhotoID(c)
+	if err != nil {
+		return nil, err
+	}
+	return &request.SetMainPhotoRequest{
+		ID:      id,
+		PhotoID: photoID,
+	}, nil
+}
+
+type PlantPhotoRequestID struct {
+	ID      string `uri:"id" binding:"required"`
+	PhotoID string `uri:"photo_id" binding:"required"`
+}
+
+func mapPlantPhotoID(c *gin.Context) (uuid.UUID, uuid.UUID, error) {
+	var req PlantPhotoRequestID
+	if err := c.ShouldBindUri(&req); err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("can't bind uri: %w", err)
+	}
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("can't parse id: %w", err)
+	}
+	photoID, err := uuid.Parse(req.PhotoID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("can't parse photo id: %w", err)
+	}
+	return id, photoID, nil
+}
+
+func MapDeletePlantPhotoRequest(c *gin.Context) (*request.DeletePlantPhotoRequest, error) {
+	id, photoID, err := mapPlantPhotoID(c)
+	if err != nil {
+		return nil, err
+	}
+	return &request.DeletePlantPhotoRequest{
+		ID:      id,
+		PhotoID: photoID,
+	}, nil
+}
+
+type UpdatePlantPhotoRequestBody struct {
+	Description string `json:"description" form:"description" binding:"required"`
+}
+
+func MapUpdatePlantPhotoRequest(c *gin.Context) (*request.UpdatePlantPhotoRequest, error) {
+	id, photoID, err := mapPlantPhotoID(c)
+	if err != nil {
+		return nil, err
+	}
+	var req UpdatePlantPhotoRequestBody
+	if err := c.ShouldBind(&req); err != nil {
+		return nil, fmt.Errorf("can't bind description: %w", err)
+	}
+	return &request.UpdatePlantPhotoRequest{
+		ID:          id,
+		PhotoID:     photoID,
+		Description: req.Description,
+	}, nil
+}
+
+type ReorderPlantPhotosRequestID struct {
+	ID string `uri:"id" binding:"required"`
+}
+
+type ReorderPlantPhotosRequestBody struct {
+	PhotoIDs []string `json:"photo_ids" binding:"required"`
+}
+
+func MapReorderPlantPhotosRequest(c *gin.Context) (*request.ReorderPlantPhotosRequest, error) {
+	var reqID ReorderPlantPhotosRequestID
+	if err := c.ShouldBindUri(&reqID); err != nil {
+		return nil, fmt.Errorf("can't bind uri: %w", err)
+	}
+	id, err := uuid.Parse(reqID.ID)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse id: %w", err)
+	}
+	var req ReorderPlantPhotosRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		return nil, fmt.Errorf("can't bind body: %w", err)
+	}
+	photoIDs := make([]uuid.UUID, 0, len(req.PhotoIDs))
+	for _, rawID := range req.PhotoIDs {
+		photoID, err := uuid.Parse(rawID)
+		if err != nil {
+			return nil, fmt.Errorf("can't parse photo id: %w", err)
+		}
+		photoIDs = append(photoIDs, photoID)
+	}
+	return &request.ReorderPlantPhotosRequest{
+		ID:       id,
+		PhotoIDs: photoIDs,
+	}, nil
+}
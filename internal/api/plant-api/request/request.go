@@ -32,3 +32,31 @@ type UploadPlantPhotoRequest struct {
 	ID          uuid.UUID `uri:"id" binding:"required"`
 	Description string    `json:"description" form:"description" binding:"required"`
 }
+
+type UpdatePlantRequest struct {
+	ID          uuid.UUID
+	Name        *string
+	LatinName   *string
+	Description *string
+}
+
+type SetMainPhotoRequest struct {
+	ID      uuid.UUID
+	PhotoID uuid.UUID
+}
+
+type DeletePlantPhotoRequest struct {
+	ID      uuid.UUID
+	PhotoID uuid.UUID
+}
+
+type UpdatePlantPhotoRequest struct {
+	ID          uuid.UUID
+	PhotoID     uuid.UUID
+	Description string
+}
+
+type ReorderPlantPhotosRequest struct {
+	ID       uuid.UUID
+	PhotoIDs []uuid.UUID
+}
@@ -7,6 +7,7 @@ import (
 	_ "PlantSite/internal/api/plant-api/spec"
 	"PlantSite/internal/models"
 	"PlantSite/internal/models/auth"
+	"PlantSite/internal/models/plant"
 	plantservice "PlantSite/internal/services/plant-service"
 
 	"errors"
@@ -28,6 +29,11 @@ func (r *PlantRouter) Init(router *gin.RouterGroup, plantService *plantservice.P
 	gr.PUT("/specification/:id", r.UpdateSpecification)
 	gr.DELETE("/delete/:id", r.Delete)
 	gr.POST("/upload/:id", r.UploadPhoto)
+	gr.PATCH("/:id", r.Update)
+	gr.PUT("/main-photo/:id/:photo_id", r.SetMainPhoto)
+	gr.DELETE("/photo/:id/:photo_id", r.DeletePhoto)
+	gr.PUT("/photo-description/:id/:photo_id", r.UpdatePhoto)
+	gr.PUT("/photo-order/:id", r.ReorderPhotos)
 }
 
 // Create plant handler
@@ -325,3 +331,230 @@ func (r *PlantRouter) UploadPhoto(c *gin.Context) {
 	}
 	c.JSON(http.StatusOK, gin.H{})
 }
+
+// @Summary Update plant
+// @Description Updates the name, latin name or description of a plant, omitted fields are left unchanged
+// @Tags plant
+// @Accept json
+// @Param id path string true "Plant ID"
+// @Param request body mapper.UpdatePlantRequestBody true "Plant fields to update"
+// @Success 200  "Plant updated successfully"
+// @Failure 400  "Bad Request - Invalid input or nothing to update"
+// @Failure 401  "Unauthorized - Not authorized to update plant"
+// @Failure 403  "Forbidden - Does not have author rights to update plant"
+// @Failure 404  "Not Found - Plant not found"
+// @Failure 500 "Internal Server Error - Failed to update plant"
+// @Router /plant/{id} [patch]
+func (r *PlantRouter) Update(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, err := mapper.MapUpdatePlantRequest(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+
+	err = r.plant.UpdatePlant(ctx, req.ID, plantservice.UpdatePlantData{
+		Name:        req.Name,
+		LatinName:   req.LatinName,
+		Description: req.Description,
+	})
+	if errors.Is(err, auth.ErrNotAuthorized) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	} else if errors.Is(err, auth.ErrNoAuthorRights) {
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	} else if errors.Is(err, plant.ErrPlantNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+// @Summary Set plant main photo
+// @Description Promotes a photo of the plant gallery to the main photo, the former main photo takes its place in the gallery
+// @Tags plant
+// @Param id path string true "Plant ID"
+// @Param photo_id path string true "Plant photo ID"
+// @Success 200  "Plant main photo updated successfully"
+// @Failure 400  "Bad Request - Invalid input"
+// @Failure 401  "Unauthorized - Not authorized to update plant"
+// @Failure 403  "Forbidden - Does not have author rights to update plant"
+// @Failure 404  "Not Found - Plant or plant photo not found"
+// @Failure 500 "Internal Server Error - Failed to update plant main photo"
+// @Router /plant/main-photo/{id}/{photo_id} [put]
+func (r *PlantRouter) SetMainPhoto(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, err := mapper.MapSetMainPhotoRequest(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+
+	err = r.plant.SetPlantMainPhoto(ctx, req.ID, req.PhotoID)
+	if errors.Is(err, auth.ErrNotAuthorized) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	} else if errors.Is(err, auth.ErrNoAuthorRights) {
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	} else if errors.Is(err, plant.ErrPlantNotFound) || errors.Is(err, plant.ErrPlantPhotoNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+// @Summary Delete plant photo
+// @Description Deletes a photo from the plant gallery together with its file
+// @Tags plant
+// @Param id path string true "Plant ID"
+// @Param photo_id path string true "Plant photo ID"
+// @Success 200  "Plant photo deleted successfully"
+// @Failure 400  "Bad Request - Invalid input"
+// @Failure 401  "Unauthorized - Not authorized to delete plant photo"
+// @Failure 403  "Forbidden - Does not have author rights to delete plant photo"
+// @Failure 404  "Not Found - Plant or plant photo not found"
+// @Failure 500 "Internal Server Error - Failed to delete plant photo"
+// @Router /plant/photo/{id}/{photo_id} [delete]
+func (r *PlantRouter) DeletePhoto(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, err := mapper.MapDeletePlantPhotoRequest(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+
+	err = r.plant.DeletePlantPhoto(ctx, req.ID, req.PhotoID)
+	if errors.Is(err, auth.ErrNotAuthorized) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	} else if errors.Is(err, auth.ErrNoAuthorRights) {
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	} else if errors.Is(err, plant.ErrPlantNotFound) || errors.Is(err, plant.ErrPlantPhotoNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+// @Summary Update plant photo
+// @Description Changes the description of a plant gallery photo
+// @Tags plant
+// @Accept json
+// @Param id path string true "Plant ID"
+// @Param photo_id path string true "Plant photo ID"
+// @Param request body mapper.UpdatePlantPhotoRequestBody true "New photo description"
+// @Success 200  "Plant photo updated successfully"
+// @Failure 400  "Bad Request - Invalid input or missing required fields"
+// @Failure 401  "Unauthorized - Not authorized to update plant photo"
+// @Failure 403  "Forbidden - Does not have author rights to update plant photo"
+// @Failure 404  "Not Found - Plant or plant photo not found"
+// @Failure 500 "Internal Server Error - Failed to update plant photo"
+// @Router /plant/photo-description/{id}/{photo_id} [put]
+func (r *PlantRouter) UpdatePhoto(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, err := mapper.MapUpdatePlantPhotoRequest(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+
+	err = r.plant.UpdatePlantPhotoDescription(ctx, req.ID, req.PhotoID, req.Description)
+	if errors.Is(err, auth.ErrNotAuthorized) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	} else if errors.Is(err, auth.ErrNoAuthorRights) {
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	} else if errors.Is(err, plant.ErrPlantNotFound) || errors.Is(err, plant.ErrPlantPhotoNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+// @Summary Reorder plant photos
+// @Description Places the plant gallery photos in the given order, every photo of the plant must be listed
+// @Tags plant
+// @Accept json
+// @Param id path string true "Plant ID"
+// @Param request body mapper.ReorderPlantPhotosRequestBody true "Photo IDs in the new order"
+// @Success 200  "Plant photos reordered successfully"
+// @Failure 400  "Bad Request - Invalid input or photo order"
+// @Failure 401  "Unauthorized - Not authorized to reorder plant photos"
+// @Failure 403  "Forbidden - Does not have author rights to reorder plant photos"
+// @Failure 404  "Not Found - Plant not found"
+// @Failure 500 "Internal Server Error - Failed to reorder plant photos"
+// @Router /plant/photo-order/{id} [put]
+func (r *PlantRouter) ReorderPhotos(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, err := mapper.MapReorderPlantPhotosRequest(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+
+	err = r.plant.ReorderPlantPhotos(ctx, req.ID, req.PhotoIDs)
+	if errors.Is(err, auth.ErrNotAuthorized) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	} else if errors.Is(err, auth.ErrNoAuthorRights) {
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	} else if errors.Is(err, plant.ErrInvalidPhotoOrder) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	} else if errors.Is(err, plant.ErrPlantNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
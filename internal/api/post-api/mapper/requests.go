@@ -2,6 +2,8 @@ package mapper
 
 import (
 	"PlantSite/internal/api/post-api/request"
+	"PlantSite/internal/models/post"
+	"PlantSite/internal/models/post/parser"
 	"fmt"
 
 	"github.com/gin-gonic/gin"
@@ -51,6 +53,7 @@ type UpdatePostRequestID struct {
 type UpdatePostRequestBody struct {
 	Title   string   `json:"title" form:"title" binding:"required"`
 	Content string   `json:"content" form:"content" binding:"required"`
+	Format  string   `json:"format" form:"format"`
 	Tags    []string `json:"tags" form:"tags"`
 }
 
@@ -75,6 +78,106 @@ func MapPostUpdateRequest(c *gin.Context) (*request.UpdateTextPostRequest, error
 		ID:      id,
 		Title:   req.Title,
 		Content: req.Content,
+		Format:  req.Format,
 		Tags:    req.Tags,
 	}, nil
 }
+
+// MapContentFormat maps the post format from request to content format with plant references.
+// Empty format means latex-like plant references in plain text.
+func MapContentFormat(format string) (post.ContentFormat, error) {
+	switch format {
+	case "", parser.LatexLikePlantParserType:
+		return post.WithPlantContentType(parser.LatexLikePlantParserType), nil
+	case parser.MarkdownPlantParserType:
+		return post.WithPlantContentType(parser.MarkdownPlantParserType), nil
+	default:
+		return "", fmt.Errorf("unsupported content format: %s", format)
+	}
+}
+
+type UploadPostPhotoRequestID struct {
+	ID string `uri:"id" binding:"required"`
+}
+
+type UploadPostPhotoRequestPlace struct {
+	PlaceNumber int `json:"place_number" form:"place_number"`
+}
+
+func MapUploadPostPhotoRequest(c *gin.Context) (*request.UploadPostPhotoRequest, error) {
+	var reqID UploadPostPhotoRequestID
+	if err := c.ShouldBindUri(&reqID); err != nil {
+		return nil, fmt.Errorf("can't bind uri: %w", err)
+	}
+	id, err := uuid.Parse(reqID.ID)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse id: %w", err)
+	}
+	var req UploadPostPhotoRequestPlace
+	if err := c.ShouldBind(&req); err != nil {
+		return nil, fmt.Errorf("can't bind place number: %w", err)
+	}
+	return &request.UploadPostPhotoRequest{
+		ID:          id,
+		PlaceNumber: req.PlaceNumber,
+	}, nil
+}
+
+type DeletePostPhotoRequest struct {
+	ID      string `uri:"id" binding:"required"`
+	PhotoID string `uri:"photo_id" binding:"required"`
+}
+
+func MapDeletePostPhotoRequest(c *gin.Context) (*request.DeletePostPhotoRequest, error) {
+	var req DeletePostPhotoRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		return nil, fmt.Errorf("can't bind uri: %w", err)
+	}
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse id: %w", err)
+	}
+	photoID, err := uuid.Parse(req.PhotoID)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse photo id: %w", err)
+	}
+	return &request.DeletePostPhotoRequest{
+		ID:      id,
+		PhotoID: photoID,
+	}, nil
+}
+
+type ReorderPostPhotosRequestID struct {
+	ID string `uri:"id" binding:"required"`
+}
+
+type ReorderPostPhotosRequestBody struct {
+	PhotoIDs []string `json:"photo_ids" binding:"required"`
+}
+
+func MapReorderPostPhotosRequest(c *gin.Context) (*request.ReorderPostPhotosRequest, error) {
+	var reqID ReorderPostPhotosRequestID
+	if err := c.ShouldBindUri(&reqID); err != nil {
+		return nil, fmt.Errorf("can't bind uri: %w", err)
+	}
+	id, err := uuid.Parse(reqID.ID)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse id: %w", err)
+	}
+	var req ReorderPostPhotosRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		return nil, fmt.Errorf("can't bind body: %w", err)
+	}
+	photoIDs := make([]uuid.UUID, 0, len(req.PhotoIDs))
+	for _, rawID := range req.PhotoIDs {
+		photoID, err := uuid.Parse(rawID)
+		if err != nil {
+			return nil, fmt.Errorf("can't parse photo id: %w", err)
+		}
+		photoIDs = append(photoIDs, photoID)
+	}
+	return &request.ReorderPostPhotosRequest{
+		ID:       id,
+		PhotoIDs: photoIDs,
+	}, nil
+}
@@ -5,6 +5,7 @@ import "github.com/google/uuid"
 type CreatePostRequest struct {
 	Title   string   `json:"title" form:"title" binding:"required"`
 	Content string   `json:"content" form:"content" binding:"required"`
+	Format  string   `json:"format" form:"format"`
 	Tags    []string `json:"tags" form:"tags"`
 }
 
@@ -20,5 +21,21 @@ type UpdateTextPostRequest struct {
 	ID      uuid.UUID `uri:"id" binding:"required"`
 	Title   string    `json:"title" form:"title" binding:"required"`
 	Content string    `json:"content" form:"content" binding:"required"`
+	Format  string    `json:"format" form:"format"`
 	Tags    []string  `json:"tags" form:"tags"`
 }
+
+type UploadPostPhotoRequest struct {
+	ID          uuid.UUID `uri:"id" binding:"required"`
+	PlaceNumber int       `json:"place_number" form:"place_number"`
+}
+
+type DeletePostPhotoRequest struct {
+	ID      uuid.UUID `uri:"id" binding:"required"`
+	PhotoID uuid.UUID `uri:"photo_id" binding:"required"`
+}
+
+type ReorderPostPhotosRequest struct {
+	ID       uuid.UUID   `uri:"id" binding:"required"`
+	PhotoIDs []uuid.UUID `json:"photo_ids" binding:"required"`
+}
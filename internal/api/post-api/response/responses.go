@@ -22,3 +22,8 @@ type GetPostPhoto struct {
 	PlaceNumber int       `json:"place_number"`
 	Key         string    `json:"key"`
 }
+
+type UploadPostPhotoResponse struct {
+	ID          uuid.UUID `json:"id"`
+	PlaceNumber int       `json:"place_number"`
+}
@@ -3,11 +3,10 @@ package postapi
 import (
 	"PlantSite/internal/api/post-api/mapper"
 	"PlantSite/internal/api/post-api/request"
-	_ "PlantSite/internal/api/post-api/response"
+	"PlantSite/internal/api/post-api/response"
 	"PlantSite/internal/models"
 	"PlantSite/internal/models/auth"
 	"PlantSite/internal/models/post"
-	"PlantSite/internal/models/post/parser"
 	postservice "PlantSite/internal/services/post-service"
 	"errors"
 	"net/http"
@@ -26,6 +25,9 @@ func (r *PostRouter) Init(router *gin.RouterGroup, post *postservice.PostService
 	gr.GET("/get/:id", r.Get)
 	gr.DELETE("/delete/:id", r.Delete)
 	gr.PUT("/text/:id", r.Update)
+	gr.POST("/photo/:id", r.UploadPhoto)
+	gr.DELETE("/photo/:id/:photo_id", r.DeletePhoto)
+	gr.PUT("/photo/:id/order", r.ReorderPhotos)
 }
 
 // @Summary Create a new post
@@ -34,10 +36,11 @@ func (r *PostRouter) Init(router *gin.RouterGroup, post *postservice.PostService
 // @Accept mpfd
 // @Param title formData string true "Post title"
 // @Param content formData string true "Post content"
+// @Param format formData string false "Content format: latex (default) or markdown"
 // @Param tags formData []string false "List of tags"
 // @Param files formData []file false "Attached files"
 // @Success 200  "Post created successfully"
-// @Failure 400  "Bad Request - Invalid input or missing required fields"
+// @Failure 400  "Bad Request - Invalid input, missing required fields or unknown plant referenced (similar plant names are returned as suggestions)"
 // @Failure 401  "Unauthorized - Not authorized to create post"
 // @Failure 403  "Forbidden - Does not have author rights to create post"
 // @Failure 500 "Internal Server Error - Failed to create post"
@@ -77,7 +80,13 @@ func (r *PostRouter) Create(c *gin.Context) {
 		})
 	}
 
-	content, err := post.NewContent(req.Content, post.WithPlantContentType(parser.LatexLikePlantParserType))
+	format, err := mapper.MapContentFormat(req.Format)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+	content, err := post.NewContent(req.Content, format)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		c.Error(err)
@@ -88,14 +97,23 @@ func (r *PostRouter) Create(c *gin.Context) {
 		Content: *content,
 		Tags:    req.Tags,
 	}, files)
+	var unknownPlant *post.UnknownPlantError
 	if errors.Is(err, auth.ErrNotAuthorized) {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 		c.Error(err)
 		return
-	} else if errors.Is(err, auth.ErrNoAuthorRights) {
+	} else if errors.Is(err, auth.ErrNoAuthorRights) || errors.Is(err, postservice.ErrNotAuthor) {
 		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
 		c.Error(err)
 		return
+	} else if errors.As(err, &unknownPlant) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "suggestions": unknownPlant.Suggestions})
+		c.Error(err)
+		return
+	} else if errors.Is(err, post.ErrContentParsingError) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
 	} else if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		c.Error(err)
@@ -157,7 +175,8 @@ func (r *PostRouter) Get(c *gin.Context) {
 // @Success 200  "Post deleted successfully"
 // @Failure 400  "Bad Request - Invalid input"
 // @Failure 401  "Unauthorized - Not authorized to delete post"
-// @Failure 403  "Forbidden - Does not have author rights to delete post"
+// @Failure 403  "Forbidden - Does not have author rights or is neither the author of the post nor an admin"
+// @Failure 404  "Not Found - Post not found"
 // @Failure 500 "Internal Server Error - Failed to delete post"
 // @Router /post/delete/{id} [delete]
 func (r *PostRouter) Delete(c *gin.Context) {
@@ -175,10 +194,14 @@ func (r *PostRouter) Delete(c *gin.Context) {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 		c.Error(err)
 		return
-	} else if errors.Is(err, auth.ErrNoAuthorRights) {
+	} else if errors.Is(err, auth.ErrNoAuthorRights) || errors.Is(err, postservice.ErrNotAuthor) {
 		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
 		c.Error(err)
 		return
+	} else if errors.Is(err, post.ErrPostNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
 	} else if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		c.Error(err)
@@ -196,9 +219,9 @@ func (r *PostRouter) Delete(c *gin.Context) {
 // @Param id path string true "Post ID"
 // @Param request body mapper.UpdatePostRequestBody true "Update post request body"
 // @Success 200  "Post updated successfully"
-// @Failure 400  "Bad Request - Invalid input or missing required fields"
+// @Failure 400  "Bad Request - Invalid input, missing required fields or unknown plant referenced (similar plant names are returned as suggestions)"
 // @Failure 401  "Unauthorized - Not authorized to update post"
-// @Failure 403  "Forbidden - Does not have author rights to update post"
+// @Failure 403  "Forbidden - Does not have author rights or is neither the author of the post nor an admin"
 // @Failure 500 "Internal Server Error - Failed to update post"
 // @Router /post/text/{id} [put]
 func (r *PostRouter) Update(c *gin.Context) {
@@ -211,7 +234,13 @@ func (r *PostRouter) Update(c *gin.Context) {
 		return
 	}
 
-	newContent, err := post.NewContent(req.Content, post.WithPlantContentType(parser.LatexLikePlantParserType))
+	format, err := mapper.MapContentFormat(req.Format)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+	newContent, err := post.NewContent(req.Content, format)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		c.Error(err)
@@ -223,14 +252,23 @@ func (r *PostRouter) Update(c *gin.Context) {
 		Content: *newContent,
 		Tags:    req.Tags,
 	})
+	var unknownPlant *post.UnknownPlantError
 	if errors.Is(err, auth.ErrNotAuthorized) {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 		c.Error(err)
 		return
-	} else if errors.Is(err, auth.ErrNoAuthorRights) {
+	} else if errors.Is(err, auth.ErrNoAuthorRights) || errors.Is(err, postservice.ErrNotAuthor) {
 		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
 		c.Error(err)
 		return
+	} else if errors.As(err, &unknownPlant) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "suggestions": unknownPlant.Suggestions})
+		c.Error(err)
+		return
+	} else if errors.Is(err, post.ErrContentParsingError) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
 	} else if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		c.Error(err)
@@ -239,3 +277,139 @@ func (r *PostRouter) Update(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{})
 }
+
+// @Summary Upload post photo
+// @Description Uploads a photo to an existing post
+// @Tags post
+// @Accept mpfd
+// @Produce json
+// @Param id path string true "Post ID"
+// @Param file formData file true "Post photo"
+// @Param place_number formData int false "Place of the photo, appended to the end if omitted"
+// @Success 200  {object} response.UploadPostPhotoResponse "Post photo uploaded successfully"
+// @Failure 400  "Bad Request - Invalid input, invalid photo or too many photos"
+// @Failure 401  "Unauthorized - Not authorized to upload post photo"
+// @Failure 403  "Forbidden - Not the author of the post"
+// @Failure 404  "Not Found - Post not found"
+// @Failure 500 "Internal Server Error - Failed to upload post photo"
+// @Router /post/photo/{id} [post]
+func (r *PostRouter) UploadPhoto(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, err := mapper.MapUploadPostPhotoRequest(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+	defer f.Close()
+
+	photo, err := r.post.AddPostPhoto(ctx, req.ID, models.FileData{
+		Name:        file.Filename,
+		ContentType: file.Header.Get("Content-Type"),
+		Reader:      f,
+	}, req.PlaceNumber)
+	if err != nil {
+		photoError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.UploadPostPhotoResponse{
+		ID:          photo.ID(),
+		PlaceNumber: photo.PlaceNumber(),
+	})
+}
+
+// @Summary Delete post photo
+// @Description Deletes a photo of a post together with its file
+// @Tags post
+// @Param id path string true "Post ID"
+// @Param photo_id path string true "Photo ID"
+// @Success 200  "Post photo deleted successfully"
+// @Failure 400  "Bad Request - Invalid input, photo not found or still placed in the post text"
+// @Failure 401  "Unauthorized - Not authorized to delete post photo"
+// @Failure 403  "Forbidden - Not the author of the post"
+// @Failure 404  "Not Found - Post not found"
+// @Failure 500 "Internal Server Error - Failed to delete post photo"
+// @Router /post/photo/{id}/{photo_id} [delete]
+func (r *PostRouter) DeletePhoto(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, err := mapper.MapDeletePostPhotoRequest(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+
+	if err := r.post.DeletePostPhoto(ctx, req.ID, req.PhotoID); err != nil {
+		photoError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+// @Summary Reorder post photos
+// @Description Places post photos in the given order, every photo of the post must be listed
+// @Tags post
+// @Accept json
+// @Param id path string true "Post ID"
+// @Param request body mapper.ReorderPostPhotosRequestBody true "Photo IDs in the new order"
+// @Success 200  "Post photos reordered successfully"
+// @Failure 400  "Bad Request - Invalid input or photo order"
+// @Failure 401  "Unauthorized - Not authorized to reorder post photos"
+// @Failure 403  "Forbidden - Not the author of the post"
+// @Failure 404  "Not Found - Post not found"
+// @Failure 500 "Internal Server Error - Failed to reorder post photos"
+// @Router /post/photo/{id}/order [put]
+func (r *PostRouter) ReorderPhotos(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, err := mapper.MapReorderPostPhotosRequest(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+
+	if err := r.post.ReorderPostPhotos(ctx, req.ID, req.PhotoIDs); err != nil {
+		photoError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+// photoError responds with the status matching a failed post photo operation.
+func photoError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, auth.ErrNotAuthorized):
+		status = http.StatusUnauthorized
+	case errors.Is(err, auth.ErrNoAuthorRights), errors.Is(err, postservice.ErrNotAuthor):
+		status = http.StatusForbidden
+	case errors.Is(err, post.ErrPostNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, postservice.ErrInvalidFileContentType),
+		errors.Is(err, post.ErrPostPhotoNotFound),
+		errors.Is(err, post.ErrMaximumPhotoCount),
+		errors.Is(err, post.ErrInvalidPhotoOrder),
+		errors.Is(err, post.ErrPhotoPlaceNotFound):
+		status = http.StatusBadRequest
+	}
+	c.JSON(status, gin.H{"error": err.Error()})
+	c.Error(err)
+}
@@ -87,3 +87,30 @@ func MapGetPostRequest(c *gin.Context) (*request.GetPostRequest, error) {
 		ID: id,
 	}, nil
 }
+
+const (
+	DefaultSuggestLimit = 10
+	MaxSuggestLimit     = 20
+)
+
+type SuggestPlantsRequest struct {
+	Name  string `form:"name" binding:"required"`
+	Limit int    `form:"limit"`
+}
+
+func MapSuggestPlantsRequest(c *gin.Context) (*request.SuggestPlantsRequest, error) {
+	var req SuggestPlantsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		return nil, fmt.Errorf("can't bind query: %w", err)
+	}
+	if req.Limit < 0 || req.Limit > MaxSuggestLimit {
+		return nil, fmt.Errorf("limit must be between 0 and %d, 0 takes the default of %d", MaxSuggestLimit, DefaultSuggestLimit)
+	}
+	if req.Limit == 0 {
+		req.Limit = DefaultSuggestLimit
+	}
+	return &request.SuggestPlantsRequest{
+		Name:  req.Name,
+		Limit: req.Limit,
+	}, nil
+}
@@ -125,3 +125,15 @@ func MapGetPostPhotos(photos []searchservice.GetPostPhoto) []response.GetPostPho
 	}
 	return res
 }
+
+func MapSuggestPlantsResponse(suggestions []*searchservice.PlantSuggestion) response.SuggestPlantsResponse {
+	resp := make(response.SuggestPlantsResponse, 0, len(suggestions))
+	for _, s := range suggestions {
+		resp = append(resp, response.PlantSuggestionItem{
+			ID:        s.ID.String(),
+			Name:      s.Name,
+			LatinName: s.LatinName,
+		})
+	}
+	return resp
+}
@@ -22,3 +22,8 @@ type GetPlantRequest struct {
 type GetPostRequest struct {
 	ID uuid.UUID `uri:"id" binding:"required"`
 }
+
+type SuggestPlantsRequest struct {
+	Name  string
+	Limit int
+}
@@ -32,3 +32,11 @@ type GetPlantPhoto struct {
 	Key         string `json:"key" form:"key" binding:"required"`
 	Description string `json:"description" form:"description" binding:"required"`
 }
+
+type PlantSuggestionItem struct {
+	ID        string `json:"id" form:"id" binding:"required"`
+	Name      string `json:"name" form:"name" binding:"required"`
+	LatinName string `json:"latin_name" form:"latin_name" binding:"required"`
+}
+
+type SuggestPlantsResponse []PlantSuggestionItem
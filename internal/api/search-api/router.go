@@ -1,6 +1,7 @@
 package searchapi
 
 import (
+	pagequery "PlantSite/internal/api-utils/query-filters/page-query"
 	plantsquery "PlantSite/internal/api-utils/query-filters/plants-query"
 	postsquery "PlantSite/internal/api-utils/query-filters/posts-query"
 	"PlantSite/internal/api/search-api/mapper"
@@ -21,6 +22,7 @@ func (r *SearchRouter) Init(router *gin.RouterGroup, search *searchservice.Searc
 	gr := router.Group("/search")
 	gr.GET("/posts", r.SearchPosts)
 	gr.GET("/plants", r.SearchPlants)
+	gr.GET("/plants/suggest", r.SuggestPlants)
 	gr.GET("/plant/:id", r.GetPlant)
 	gr.GET("/post/:id", r.GetPost)
 }
@@ -31,6 +33,10 @@ func (r *SearchRouter) Init(router *gin.RouterGroup, search *searchservice.Searc
 // @Accept json
 // @Produce json
 // @Param request body []mapper.SearchPostsItem true "Array of search filters"
+// @Param limit query int false "Page size"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param q query string false "Full-text query over post title and body"
+// @Param sort query string false "Sort key: title, created_at, updated_at or relevance, prefixed with - for descending order, relevance is never descending"
 // @Success 200 {object} response.SearchPostsResponse
 // @Failure 400 "Invalid request format or missing required fields"
 // @Failure 500 "Internal server error"
@@ -51,13 +57,23 @@ func (r *SearchRouter) SearchPosts(c *gin.Context) {
 		c.Error(err)
 		return
 	}
+	total, err := r.search.CountPosts(ctx, srch)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
 	resp := mapper.MapSearchPostsResponse(posts)
 	if resp == nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "post not found"})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"posts": resp})
+	c.JSON(http.StatusOK, gin.H{
+		"posts":       resp,
+		"total":       total,
+		"next_cursor": pagequery.NextCursor(srch.Page(), len(posts), total),
+	})
 }
 
 // @Summary Search plants with multiple filters
@@ -66,6 +82,10 @@ func (r *SearchRouter) SearchPosts(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param request body []mapper.SearchPlantsItem true "Array of search filters"
+// @Param limit query int false "Page size"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param q query string false "Full-text query over plant name, latin name and description"
+// @Param sort query string false "Sort key: name, created_at, updated_at, height_m or relevance, prefixed with - for descending order, relevance is never descending"
 // @Success 200 {object} response.SearchPlantResponse
 // @Failure 400 "Invalid request format or missing required fields"
 // @Failure 500 "Internal server error"
@@ -86,6 +106,12 @@ func (r *SearchRouter) SearchPlants(c *gin.Context) {
 		c.Error(err)
 		return
 	}
+	total, err := r.search.CountPlants(ctx, srch)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
 
 	resp, err := mapper.MapSearchPlantsResponse(plants)
 	if err != nil {
@@ -94,7 +120,41 @@ func (r *SearchRouter) SearchPlants(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"plants": resp})
+	c.JSON(http.StatusOK, gin.H{
+		"plants":      resp,
+		"total":       total,
+		"next_cursor": pagequery.NextCursor(srch.Page(), len(plants), total),
+	})
+}
+
+// @Summary Suggest plant names
+// @Description Typo-tolerant plant name autocomplete over name and latin name
+// @Tags search
+// @Produce json
+// @Param name query string true "Part of the plant name or latin name"
+// @Param limit query int false "Maximum number of suggestions, up to 20, 10 if omitted or 0"
+// @Success 200 {object} response.SuggestPlantsResponse
+// @Failure 400 "Invalid request format or missing required fields"
+// @Failure 500 "Internal server error"
+// @Router /search/plants/suggest [get]
+func (r *SearchRouter) SuggestPlants(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, err := mapper.MapSuggestPlantsRequest(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+
+	suggestions, err := r.search.SuggestPlants(ctx, req.Name, req.Limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.MapSuggestPlantsResponse(suggestions))
 }
 
 // @Summary Get post
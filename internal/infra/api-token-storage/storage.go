@@ -0,0 +1,66 @@
+package apitokenstorage
+
+import (
+	authservice "PlantSite/internal/services/auth-service"
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+type MapAPITokenStorage struct {
+	storage map[string]*authservice.APIToken
+	mutex   sync.Mutex
+}
+
+func NewMapAPITokenStorage() *MapAPITokenStorage {
+	return &MapAPITokenStorage{
+		storage: make(map[string]*authservice.APIToken),
+		mutex:   sync.Mutex{},
+	}
+}
+
+func (storage *MapAPITokenStorage) Store(ctx context.Context, token *authservice.APIToken) error {
+	storage.mutex.Lock()
+	defer storage.mutex.Unlock()
+	storage.storage[string(token.TokenHash)] = token
+	return nil
+}
+
+func (storage *MapAPITokenStorage) GetByHash(ctx context.Context, tokenHash []byte) (*authservice.APIToken, error) {
+	storage.mutex.Lock()
+	defer storage.mutex.Unlock()
+	token, ok := storage.storage[string(tokenHash)]
+	if !ok {
+		return nil, authservice.ErrAPITokenNotFound
+	}
+	return token, nil
+}
+
+func (storage *MapAPITokenStorage) List(ctx context.Context, memberID uuid.UUID) ([]*authservice.APIToken, error) {
+	storage.mutex.Lock()
+	defer storage.mutex.Unlock()
+	tokens := make([]*authservice.APIToken, 0)
+	for _, token := range storage.storage {
+		if token.MemberID == memberID {
+			tokens = append(tokens, token)
+		}
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].CreatedAt.Before(tokens[j].CreatedAt)
+	})
+	return tokens, nil
+}
+
+func (storage *MapAPITokenStorage) Delete(ctx context.Context, memberID, id uuid.UUID) error {
+	storage.mutex.Lock()
+	defer storage.mutex.Unlock()
+	for hash, token := range storage.storage {
+		if token.ID == id && token.MemberID == memberID {
+			delete(storage.storage, hash)
+			return nil
+		}
+	}
+	return authservice.ErrAPITokenNotFound
+}
@@ -0,0 +1,64 @@
+package attemptstorage
+
+import (
+	authservice "PlantSite/internal/services/auth-service"
+	"context"
+	"sync"
+	"time"
+)
+
+type MapLoginAttemptStorage struct {
+	storage map[string]*authservice.LoginAttempts
+	mutex   sync.Mutex
+}
+
+func NewMapLoginAttemptStorage() *MapLoginAttemptStorage {
+	return &MapLoginAttemptStorage{
+		storage: make(map[string]*authservice.LoginAttempts),
+		mutex:   sync.Mutex{},
+	}
+}
+
+func (storage *MapLoginAttemptStorage) Get(ctx context.Context, key string) (*authservice.LoginAttempts, error) {
+	storage.mutex.Lock()
+	defer storage.mutex.Unlock()
+	attempts, ok := storage.storage[key]
+	if !ok || attempts.ExpiresAt.Before(time.Now()) {
+		return nil, authservice.ErrNoLoginAttempts
+	}
+	copied := *attempts
+	return &copied, nil
+}
+
+func (storage *MapLoginAttemptStorage) AddFailure(ctx context.Context, key string, at, expiresAt time.Time) (*authservice.LoginAttempts, error) {
+	storage.mutex.Lock()
+	defer storage.mutex.Unlock()
+	attempts, ok := storage.storage[key]
+	if !ok || attempts.ExpiresAt.Before(at) {
+		attempts = &authservice.LoginAttempts{Key: key}
+		storage.storage[key] = attempts
+	}
+	attempts.Failures++
+	attempts.LastFailure = at
+	attempts.ExpiresAt = expiresAt
+	copied := *attempts
+	return &copied, nil
+}
+
+func (storage *MapLoginAttemptStorage) Delete(ctx context.Context, key string) error {
+	storage.mutex.Lock()
+	defer storage.mutex.Unlock()
+	delete(storage.storage, key)
+	return nil
+}
+
+func (storage *MapLoginAttemptStorage) ClearExpired(ctx context.Context) error {
+	storage.mutex.Lock()
+	defer storage.mutex.Unlock()
+	for key, attempts := range storage.storage {
+		if attempts.ExpiresAt.Before(time.Now()) {
+			delete(storage.storage, key)
+		}
+	}
+	return nil
+}
@@ -1,11 +1,13 @@
 package plantfilters
 
 import (
+	"PlantSite/internal/models/album"
 	"PlantSite/internal/models/search"
 
 	registry "PlantSite/internal/infra/filters/registry"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
 )
 
 func init() {
@@ -20,11 +22,33 @@ func PlantAlbumFilterFactory(ps search.PlantFilter) (registry.PostgresPlantFilte
 		return nil, registry.ErrInvalidFilterType
 	}
 
+	albumSubquery := squirrel.Select("id").
+		From("album").
+		Where(squirrel.Eq{"id": pf.AlbumID})
+	if !pf.Reader.ReadAny {
+		albumSubquery = albumSubquery.Where(readableAlbums(pf.Reader))
+	}
+
 	tagSubquery := squirrel.Select("plant_id").
 		From("plant_album").
-		Where(squirrel.Eq{"album_id": pf.AlbumID})
+		Where(squirrel.Expr("album_id IN (?)", albumSubquery))
 
 	filt := squirrel.Expr("id IN (?)", tagSubquery)
 
 	return filt, nil
 }
+
+// readableAlbums is the condition of album.Reader.CanRead
+func readableAlbums(reader album.Reader) squirrel.Or {
+	readable := squirrel.Or{squirrel.Eq{"visibility": string(album.VisibilityPublic)}}
+	if reader.ID != uuid.Nil {
+		readable = append(readable, squirrel.Eq{"owner_id": reader.ID})
+	}
+	if reader.ShareToken != "" {
+		readable = append(readable, squirrel.Eq{
+			"visibility":  string(album.VisibilityUnlisted),
+			"share_token": reader.ShareToken,
+		})
+	}
+	return readable
+}
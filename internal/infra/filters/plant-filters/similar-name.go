@@ -0,0 +1,27 @@
+package plantfilters
+
+import (
+	registry "PlantSite/internal/infra/filters/registry"
+	pgconsts "PlantSite/internal/infra/pg-consts"
+	"PlantSite/internal/models/search"
+
+	"github.com/Masterminds/squirrel"
+)
+
+func init() {
+	registry.RegisterPlantFilter(search.PlantSimilarNameFilterID, PlantSimilarNameFilterFactory)
+}
+
+var _ registry.PlantFilterFactory = PlantSimilarNameFilterFactory
+
+func PlantSimilarNameFilterFactory(f search.PlantFilter) (registry.PostgresPlantFilter, error) {
+	pf, ok := f.(*search.PlantSimilarNameFilter)
+	if !ok {
+		return nil, registry.ErrInvalidFilterType
+	}
+
+	// name % {name} OR latin_name % {name} OR {name} <% name OR {name} <% latin_name
+	filt := squirrel.Expr(pgconsts.TrigramMatchExpr, pf.Name, pf.Name, pf.Name, pf.Name)
+
+	return filt, nil
+}
@@ -0,0 +1,31 @@
+package plantfilters
+
+import (
+	registry "PlantSite/internal/infra/filters/registry"
+	pgconsts "PlantSite/internal/infra/pg-consts"
+	"PlantSite/internal/models/search"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+)
+
+func init() {
+	registry.RegisterPlantFilter(search.PlantTextFilterID, PlantTextFilterFactory)
+}
+
+var _ registry.PlantFilterFactory = PlantTextFilterFactory
+
+func PlantTextFilterFactory(f search.PlantFilter) (registry.PostgresPlantFilter, error) {
+	pf, ok := f.(*search.PlantTextFilter)
+	if !ok {
+		return nil, registry.ErrInvalidFilterType
+	}
+
+	// search_vector @@ {russian query} || {english query}
+	filt := squirrel.Expr(
+		fmt.Sprintf("%s @@ %s", pgconsts.SearchVectorColumn, pgconsts.TsQueryExpr),
+		pf.Query, pf.Query,
+	)
+
+	return filt, nil
+}
@@ -0,0 +1,31 @@
+package postfilters
+
+import (
+	registry "PlantSite/internal/infra/filters/registry"
+	pgconsts "PlantSite/internal/infra/pg-consts"
+	"PlantSite/internal/models/search"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+)
+
+func init() {
+	registry.RegisterPostFilter(search.PostTextFilterID, PostTextFilterFactory)
+}
+
+var _ registry.PostFilterFactory = PostTextFilterFactory
+
+func PostTextFilterFactory(ps search.PostFilter) (registry.PostgresPostFilter, error) {
+	pf, ok := ps.(*search.PostTextFilter)
+	if !ok {
+		return nil, registry.ErrInvalidFilterType
+	}
+
+	// search_vector @@ {russian query} || {english query}
+	filt := squirrel.Expr(
+		fmt.Sprintf("%s @@ %s", pgconsts.SearchVectorColumn, pgconsts.TsQueryExpr),
+		pf.Query, pf.Query,
+	)
+
+	return filt, nil
+}
@@ -0,0 +1,43 @@
+package identitystorage
+
+import (
+	authservice "PlantSite/internal/services/auth-service"
+	"context"
+	"sync"
+)
+
+var _ authservice.ExternalIdentityStorage = (*MapExternalIdentityStorage)(nil)
+
+type identityKey struct {
+	issuer  string
+	subject string
+}
+
+type MapExternalIdentityStorage struct {
+	storage map[identityKey]*authservice.ExternalIdentity
+	mutex   sync.Mutex
+}
+
+func NewMapExternalIdentityStorage() *MapExternalIdentityStorage {
+	return &MapExternalIdentityStorage{
+		storage: make(map[identityKey]*authservice.ExternalIdentity),
+		mutex:   sync.Mutex{},
+	}
+}
+
+func (storage *MapExternalIdentityStorage) Store(ctx context.Context, identity *authservice.ExternalIdentity) error {
+	storage.mutex.Lock()
+	defer storage.mutex.Unlock()
+	storage.storage[identityKey{identity.Issuer, identity.Subject}] = identity
+	return nil
+}
+
+func (storage *MapExternalIdentityStorage) Get(ctx context.Context, issuer, subject string) (*authservice.ExternalIdentity, error) {
+	storage.mutex.Lock()
+	defer storage.mutex.Unlock()
+	identity, ok := storage.storage[identityKey{issuer, subject}]
+	if !ok {
+		return nil, authservice.ErrExternalIdentityNotFound
+	}
+	return identity, nil
+}
@@ -0,0 +1,25 @@
+package mailer
+
+import (
+	authservice "PlantSite/internal/services/auth-service"
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+)
+
+// headerReplacer keeps values in a single header line, so that they can't add headers
+var headerReplacer = strings.NewReplacer("\r", "", "\n", "")
+
+func formatMail(from string, mail authservice.Mail) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", headerReplacer.Replace(from))
+	fmt.Fprintf(&b, "To: %s\r\n", headerReplacer.Replace(mail.To))
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", headerReplacer.Replace(mail.Subject)))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(mail.Body, "\n", "\r\n"))
+	return []byte(b.String())
+}
@@ -0,0 +1,37 @@
+package mailer
+
+import (
+	authservice "PlantSite/internal/services/auth-service"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var _ authservice.Mailer = (*OutboxMailer)(nil)
+
+// OutboxMailer writes mails as .eml files into a directory instead of sending them,
+// for development and tests.
+type OutboxMailer struct {
+	dir  string
+	from string
+}
+
+func NewOutboxMailer(dir, from string) (*OutboxMailer, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("can't create outbox directory: %w", err)
+	}
+	return &OutboxMailer{dir: dir, from: from}, nil
+}
+
+func (m *OutboxMailer) Send(ctx context.Context, mail authservice.Mail) error {
+	name := fmt.Sprintf("%s-%s.eml", time.Now().Format("20060102T150405"), uuid.New())
+	err := os.WriteFile(filepath.Join(m.dir, name), formatMail(m.from, mail), 0o644)
+	if err != nil {
+		return fmt.Errorf("OutboxMailer.Send failed %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package mailer
+
+import (
+	authservice "PlantSite/internal/services/auth-service"
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+)
+
+var _ authservice.Mailer = (*SMTPMailer)(nil)
+
+type SMTPConfig struct {
+	Host     string
+	Port     uint
+	User     string
+	Password string
+	From     string
+}
+
+type SMTPMailer struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+func NewSMTPMailer(cfg SMTPConfig) (*SMTPMailer, error) {
+	if cfg.Host == "" {
+		return nil, fmt.Errorf("smtp host should not be empty")
+	}
+	if cfg.From == "" {
+		return nil, fmt.Errorf("smtp sender should not be empty")
+	}
+	var auth smtp.Auth
+	if cfg.User != "" {
+		auth = smtp.PlainAuth("", cfg.User, cfg.Password, cfg.Host)
+	}
+	return &SMTPMailer{
+		addr: net.JoinHostPort(cfg.Host, strconv.FormatUint(uint64(cfg.Port), 10)),
+		auth: auth,
+		from: cfg.From,
+	}, nil
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, mail authservice.Mail) error {
+	err := smtp.SendMail(m.addr, m.auth, m.from, []string{mail.To}, formatMail(m.from, mail))
+	if err != nil {
+		return fmt.Errorf("SMTPMailer.Send failed %w", err)
+	}
+	return nil
+}
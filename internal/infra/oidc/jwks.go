@@ -0,0 +1,165 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// keyRefreshInterval limits how often the keys are fetched again, so tokens
+// with made up key ids can't make the api hammer the provider.
+const keyRefreshInterval = time.Minute
+
+// minRSAKeyBits is the smallest accepted RSA modulus.
+const minRSAKeyBits = 2048
+
+type jwk struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Use     string `json:"use"`
+	N       string `json:"n"`
+	E       string `json:"e"`
+}
+
+type jwks struct {
+	Keys []jwk `json:"keys"`
+}
+
+type jwtHeader struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+}
+
+// keySet holds the signing keys of the provider. Keys are fetched again
+// when a token is signed with an unknown key, so that key rotation needs no restart.
+// The mutex guards the keys and is never held during a fetch, refreshMutex
+// lets one fetch run at a time.
+type keySet struct {
+	uri          string
+	client       *http.Client
+	keys         map[string]*rsa.PublicKey
+	mutex        sync.Mutex
+	refreshMutex sync.Mutex
+	refreshedAt  time.Time
+}
+
+// verify checks the signature of the JWT and returns its payload.
+func (ks *keySet) verify(ctx context.Context, token string) ([]byte, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed", ErrInvalidIDToken)
+	}
+	headerJSON, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidIDToken, err)
+	}
+	var header jwtHeader
+	if err := json.Unmarshal(headerJSON, &header); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidIDToken, err)
+	}
+	if header.Algorithm != "RS256" {
+		return nil, fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidIDToken, header.Algorithm)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidIDToken, err)
+	}
+
+	key, err := ks.key(ctx, header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidIDToken, err)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidIDToken, err)
+	}
+	return payload, nil
+}
+
+func (ks *keySet) key(ctx context.Context, keyID string) (*rsa.PublicKey, error) {
+	if key, ok := ks.cached(keyID); ok {
+		return key, nil
+	}
+
+	ks.refreshMutex.Lock()
+	defer ks.refreshMutex.Unlock()
+	// Another request could have fetched the key while this one waited
+	if key, ok := ks.cached(keyID); ok {
+		return key, nil
+	}
+	if time.Since(ks.refreshedAt) < keyRefreshInterval {
+		return nil, fmt.Errorf("%w: unknown key %q", ErrInvalidIDToken, keyID)
+	}
+	// Failed fetches count as well, a provider that is down isn't asked on every login
+	ks.refreshedAt = time.Now()
+	if err := ks.refresh(ctx); err != nil {
+		return nil, err
+	}
+	if key, ok := ks.cached(keyID); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("%w: unknown key %q", ErrInvalidIDToken, keyID)
+}
+
+func (ks *keySet) cached(keyID string) (*rsa.PublicKey, bool) {
+	ks.mutex.Lock()
+	defer ks.mutex.Unlock()
+	key, ok := ks.keys[keyID]
+	return key, ok
+}
+
+func (ks *keySet) refresh(ctx context.Context) error {
+	var set jwks
+	if err := getJSON(ctx, ks.client, ks.uri, &set); err != nil {
+		return fmt.Errorf("oidc keys fetch failed %w", err)
+	}
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.KeyType != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		key, err := k.rsaKey()
+		if err != nil {
+			return fmt.Errorf("oidc key %q is invalid %w", k.KeyID, err)
+		}
+		keys[k.KeyID] = key
+	}
+	ks.mutex.Lock()
+	ks.keys = keys
+	ks.mutex.Unlock()
+	return nil
+}
+
+func (k jwk) rsaKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, err
+	}
+	modulus := new(big.Int).SetBytes(n)
+	if modulus.BitLen() < minRSAKeyBits {
+		return nil, fmt.Errorf("key is shorter than %d bits", minRSAKeyBits)
+	}
+	exponent := new(big.Int).SetBytes(e)
+	if !exponent.IsInt64() || exponent.Int64() > 1<<31-1 || exponent.Int64() < 3 {
+		return nil, fmt.Errorf("unsupported exponent")
+	}
+	return &rsa.PublicKey{N: modulus, E: int(exponent.Int64())}, nil
+}
@@ -0,0 +1,84 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newJWK(t *testing.T, keyID string, bits int) jwk {
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	require.NoError(t, err)
+	return jwk{
+		KeyType: "RSA",
+		KeyID:   keyID,
+		Use:     "sig",
+		N:       base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:       base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func TestKeySet(t *testing.T) {
+	ctx := context.Background()
+	key := newJWK(t, "key-1", 2048)
+
+	newKeySet := func(t *testing.T) (*keySet, *atomic.Int32) {
+		var fetches atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			fetches.Add(1)
+			require.NoError(t, json.NewEncoder(w).Encode(jwks{Keys: []jwk{key}}))
+		}))
+		t.Cleanup(server.Close)
+		return &keySet{uri: server.URL, client: server.Client()}, &fetches
+	}
+
+	t.Run("KnownKey", func(t *testing.T) {
+		ks, fetches := newKeySet(t)
+		_, err := ks.key(ctx, "key-1")
+		require.NoError(t, err)
+		_, err = ks.key(ctx, "key-1")
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), fetches.Load())
+	})
+
+	t.Run("UnknownKeyThrottled", func(t *testing.T) {
+		ks, fetches := newKeySet(t)
+		_, err := ks.key(ctx, "key-1")
+		require.NoError(t, err)
+
+		for range 3 {
+			_, err = ks.key(ctx, "made-up")
+			require.ErrorIs(t, err, ErrInvalidIDToken)
+		}
+		assert.Equal(t, int32(1), fetches.Load())
+
+		ks.refreshedAt = time.Now().Add(-keyRefreshInterval)
+		_, err = ks.key(ctx, "made-up")
+		require.ErrorIs(t, err, ErrInvalidIDToken)
+		assert.Equal(t, int32(2), fetches.Load())
+	})
+}
+
+func TestRSAKey(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		key, err := newJWK(t, "key", 2048).rsaKey()
+		require.NoError(t, err)
+		assert.Equal(t, 2048, key.N.BitLen())
+	})
+
+	t.Run("ShortModulus", func(t *testing.T) {
+		_, err := newJWK(t, "key", 1024).rsaKey()
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,248 @@
+package oidc
+
+import (
+	authservice "PlantSite/internal/services/auth-service"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+var _ authservice.OIDCProvider = (*Provider)(nil)
+
+var ErrInvalidIDToken = errors.New("invalid id token")
+
+// clockSkew is tolerated between the clocks of the provider and the api.
+const clockSkew = time.Minute
+
+type Config struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+}
+
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Provider is an OpenID Connect identity provider, endpoints are taken from its discovery document.
+// Only RS256 signed ID tokens are accepted.
+type Provider struct {
+	config    Config
+	endpoints discovery
+	client    *http.Client
+	keys      *keySet
+}
+
+func NewProvider(ctx context.Context, cfg Config, client *http.Client) (*Provider, error) {
+	if cfg.Issuer == "" {
+		return nil, fmt.Errorf("oidc issuer should not be empty")
+	}
+	if cfg.ClientID == "" {
+		return nil, fmt.Errorf("oidc client id should not be empty")
+	}
+	if cfg.RedirectURL == "" {
+		return nil, fmt.Errorf("oidc redirect url should not be empty")
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	var endpoints discovery
+	wellKnown := strings.TrimSuffix(cfg.Issuer, "/") + "/.well-known/openid-configuration"
+	if err := getJSON(ctx, client, wellKnown, &endpoints); err != nil {
+		return nil, fmt.Errorf("oidc discovery failed %w", err)
+	}
+	// The document must be of the configured issuer, otherwise tokens of another issuer would be accepted
+	if endpoints.Issuer != cfg.Issuer {
+		return nil, fmt.Errorf("oidc discovery issuer %q doesn't match %q", endpoints.Issuer, cfg.Issuer)
+	}
+	if endpoints.AuthorizationEndpoint == "" || endpoints.TokenEndpoint == "" || endpoints.JWKSURI == "" {
+		return nil, fmt.Errorf("oidc discovery document is incomplete")
+	}
+
+	return &Provider{
+		config:    cfg,
+		endpoints: endpoints,
+		client:    client,
+		keys:      &keySet{uri: endpoints.JWKSURI, client: client, mutex: sync.Mutex{}},
+	}, nil
+}
+
+func (p *Provider) AuthCodeURL(state, nonce, codeChallenge string) string {
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.config.ClientID},
+		"redirect_uri":          {p.config.RedirectURL},
+		"scope":                 {"openid email profile"},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {codeChallenge},
+		"code_challenge_method": {"S256"},
+	}
+	separator := "?"
+	if strings.Contains(p.endpoints.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+	return p.endpoints.AuthorizationEndpoint + separator + query.Encode()
+}
+
+type tokenResponse struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier string) (*authservice.OIDCIdentity, error) {
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.config.RedirectURL},
+		"client_id":     {p.config.ClientID},
+		"code_verifier": {codeVerifier},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoints.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("Provider.Exchange failed %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.config.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.config.ClientID), url.QueryEscape(p.config.ClientSecret))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Provider.Exchange failed %w", err)
+	}
+	defer resp.Body.Close()
+
+	var token tokenResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&token); err != nil {
+		return nil, fmt.Errorf("Provider.Exchange failed %w", err)
+	}
+	if resp.StatusCode != http.StatusOK || token.Error != "" {
+		return nil, fmt.Errorf("Provider.Exchange failed: %d %s %s", resp.StatusCode, token.Error, token.ErrorDescription)
+	}
+	if token.IDToken == "" {
+		return nil, fmt.Errorf("%w: token response has no id token", ErrInvalidIDToken)
+	}
+
+	return p.verify(ctx, token.IDToken)
+}
+
+type claims struct {
+	Issuer            string   `json:"iss"`
+	Subject           string   `json:"sub"`
+	Audience          audience `json:"aud"`
+	Expiry            int64    `json:"exp"`
+	Nonce             string   `json:"nonce"`
+	Email             string   `json:"email"`
+	EmailVerified     flag     `json:"email_verified"`
+	PreferredUsername string   `json:"preferred_username"`
+	Name              string   `json:"name"`
+}
+
+// verify checks the signature and the claims of the ID token, the nonce is left to the caller.
+func (p *Provider) verify(ctx context.Context, idToken string) (*authservice.OIDCIdentity, error) {
+	payload, err := p.keys.verify(ctx, idToken)
+	if err != nil {
+		return nil, err
+	}
+	var c claims
+	if err := json.Unmarshal(payload, &c); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidIDToken, err)
+	}
+
+	if c.Issuer != p.config.Issuer {
+		return nil, fmt.Errorf("%w: issuer %q", ErrInvalidIDToken, c.Issuer)
+	}
+	if !c.Audience.contains(p.config.ClientID) {
+		return nil, fmt.Errorf("%w: not issued for the client", ErrInvalidIDToken)
+	}
+	if time.Unix(c.Expiry, 0).Add(clockSkew).Before(time.Now()) {
+		return nil, fmt.Errorf("%w: expired", ErrInvalidIDToken)
+	}
+	if c.Subject == "" {
+		return nil, fmt.Errorf("%w: no subject", ErrInvalidIDToken)
+	}
+
+	username := c.PreferredUsername
+	if username == "" {
+		username = c.Name
+	}
+	return &authservice.OIDCIdentity{
+		Issuer:        c.Issuer,
+		Subject:       c.Subject,
+		Email:         c.Email,
+		EmailVerified: bool(c.EmailVerified),
+		Username:      username,
+		Nonce:         c.Nonce,
+	}, nil
+}
+
+// audience is the aud claim, a single string or an array of them.
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*a = many
+	return nil
+}
+
+func (a audience) contains(clientID string) bool {
+	for _, aud := range a {
+		if aud == clientID {
+			return true
+		}
+	}
+	return false
+}
+
+// flag is a boolean claim, some providers send it as a string.
+type flag bool
+
+func (f *flag) UnmarshalJSON(data []byte) error {
+	switch string(data) {
+	case "true", `"true"`:
+		*f = true
+	default:
+		*f = false
+	}
+	return nil
+}
+
+func getJSON(ctx context.Context, client *http.Client, uri string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", uri, resp.Status)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(v)
+}
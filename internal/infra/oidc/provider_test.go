@@ -0,0 +1,79 @@
+package oidc_test
+
+import (
+	"PlantSite/internal/infra/oidc"
+	"PlantSite/internal/infra/oidc/oidctest"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func challenge(verifier string) string {
+	hash := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+func TestProvider(t *testing.T) {
+	ctx := context.Background()
+	issuer := oidctest.NewIssuer("plant-site", "secret")
+	defer issuer.Close()
+
+	newProvider := func(t *testing.T, clientID string) *oidc.Provider {
+		provider, err := oidc.NewProvider(ctx, oidc.Config{
+			Issuer:       issuer.URL(),
+			ClientID:     clientID,
+			ClientSecret: "secret",
+			RedirectURL:  "http://localhost/api/auth/oidc/callback",
+		}, issuer.Client())
+		require.NoError(t, err)
+		return provider
+	}
+	account := oidctest.Account{
+		Subject:       "user-1",
+		Email:         "user@example.com",
+		EmailVerified: true,
+		Username:      "user",
+	}
+
+	t.Run("Exchange", func(t *testing.T) {
+		provider := newProvider(t, "plant-site")
+		state, code, err := issuer.Authorize(provider.AuthCodeURL("state", "nonce", challenge("verifier")), account)
+		require.NoError(t, err)
+		assert.Equal(t, "state", state)
+
+		identity, err := provider.Exchange(ctx, code, "verifier")
+		require.NoError(t, err)
+		assert.Equal(t, issuer.URL(), identity.Issuer)
+		assert.Equal(t, "user-1", identity.Subject)
+		assert.Equal(t, "user@example.com", identity.Email)
+		assert.True(t, identity.EmailVerified)
+		assert.Equal(t, "user", identity.Username)
+		assert.Equal(t, "nonce", identity.Nonce)
+
+		// Codes are single-use
+		_, err = provider.Exchange(ctx, code, "verifier")
+		assert.Error(t, err)
+	})
+
+	t.Run("WrongVerifier", func(t *testing.T) {
+		provider := newProvider(t, "plant-site")
+		_, code, err := issuer.Authorize(provider.AuthCodeURL("state", "nonce", challenge("verifier")), account)
+		require.NoError(t, err)
+
+		_, err = provider.Exchange(ctx, code, "other")
+		assert.Error(t, err)
+	})
+
+	t.Run("WrongIssuer", func(t *testing.T) {
+		_, err := oidc.NewProvider(ctx, oidc.Config{
+			Issuer:      issuer.URL() + "/other",
+			ClientID:    "plant-site",
+			RedirectURL: "http://localhost/api/auth/oidc/callback",
+		}, issuer.Client())
+		assert.Error(t, err)
+	})
+}
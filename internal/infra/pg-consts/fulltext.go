@@ -0,0 +1,7 @@
+package pgconsts
+
+const (
+	SearchVectorColumn = "search_vector"
+	// TsQueryExpr matches both russian and english stems, query text is passed twice.
+	TsQueryExpr = "(websearch_to_tsquery('russian', ?) || websearch_to_tsquery('english', ?))"
+)
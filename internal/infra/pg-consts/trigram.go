@@ -0,0 +1,10 @@
+package pgconsts
+
+const (
+	// TrigramMatchExpr matches plants whose name or latin name is similar to the query,
+	// either as a whole or by one of the words. Query text is passed four times.
+	TrigramMatchExpr = "(lower(name) % lower(?) OR lower(latin_name) % lower(?) OR lower(?) <% lower(name) OR lower(?) <% lower(latin_name))"
+	// TrigramRankExpr is the best similarity of the query to name or latin name.
+	// Query text is passed four times.
+	TrigramRankExpr = "GREATEST(similarity(lower(name), lower(?)), similarity(lower(latin_name), lower(?)), word_similarity(lower(?), lower(name)), word_similarity(lower(?), lower(latin_name)))"
+)
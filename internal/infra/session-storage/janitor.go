@@ -0,0 +1,35 @@
+package sessionstorage
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ExpiringStorage drops its expired entries on request, like sessions or password reset tokens.
+type ExpiringStorage interface {
+	ClearExpired(ctx context.Context) error
+}
+
+// RunJanitor clears expired entries of the storage every interval until ctx is done.
+// It doesn't block, the storage is cleared in a separate goroutine.
+func RunJanitor(ctx context.Context, storage ExpiringStorage, interval time.Duration, logger *zap.SugaredLogger) {
+	if interval <= 0 {
+		panic("non-positive janitor interval")
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := storage.ClearExpired(ctx); err != nil {
+					logger.Errorw("Failed to clear expired entries", "error", err)
+				}
+			}
+		}
+	}()
+}
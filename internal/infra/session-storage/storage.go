@@ -3,6 +3,7 @@ package sessionstorage
 import (
 	authservice "PlantSite/internal/services/auth-service"
 	"context"
+	"sort"
 	"sync"
 	"time"
 
@@ -42,6 +43,21 @@ func (storage *MapSessionStorage) Store(ctx context.Context, sid uuid.UUID, sess
 	return nil
 }
 
+func (storage *MapSessionStorage) Touch(ctx context.Context, sid uuid.UUID, session *authservice.Session) error {
+	storage.mutex.Lock()
+	defer storage.mutex.Unlock()
+	stored, ok := storage.storage[sid]
+	if !ok {
+		return authservice.ErrSessionNotFound
+	}
+	touched := *stored
+	touched.ExpiresAt = session.ExpiresAt
+	touched.LastSeenAt = session.LastSeenAt
+	touched.IP = session.IP
+	storage.storage[sid] = &touched
+	return nil
+}
+
 func (storage *MapSessionStorage) Delete(ctx context.Context, sid uuid.UUID) error {
 	storage.mutex.Lock()
 	defer storage.mutex.Unlock()
@@ -49,6 +65,33 @@ func (storage *MapSessionStorage) Delete(ctx context.Context, sid uuid.UUID) err
 	return nil
 }
 
+func (storage *MapSessionStorage) ListByMember(ctx context.Context, memberID uuid.UUID) ([]*authservice.Session, error) {
+	storage.mutex.RLock()
+	defer storage.mutex.RUnlock()
+	sessions := make([]*authservice.Session, 0)
+	now := time.Now()
+	for _, session := range storage.storage {
+		if session.MemberID == memberID && !session.ExpiresAt.Before(now) {
+			sessions = append(sessions, session)
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeenAt.After(sessions[j].LastSeenAt)
+	})
+	return sessions, nil
+}
+
+func (storage *MapSessionStorage) DeleteByMember(ctx context.Context, memberID uuid.UUID, except uuid.UUID) error {
+	storage.mutex.Lock()
+	defer storage.mutex.Unlock()
+	for sid, session := range storage.storage {
+		if session.MemberID == memberID && sid != except {
+			delete(storage.storage, sid)
+		}
+	}
+	return nil
+}
+
 func (storage *MapSessionStorage) ClearExpired(ctx context.Context) error {
 	storage.mutex.Lock()
 	defer storage.mutex.Unlock()
@@ -0,0 +1,49 @@
+package tokenstorage
+
+import (
+	authservice "PlantSite/internal/services/auth-service"
+	"context"
+	"sync"
+	"time"
+)
+
+type MapMemberTokenStorage struct {
+	storage map[string]*authservice.MemberToken
+	mutex   sync.Mutex
+}
+
+func NewMapMemberTokenStorage() *MapMemberTokenStorage {
+	return &MapMemberTokenStorage{
+		storage: make(map[string]*authservice.MemberToken),
+		mutex:   sync.Mutex{},
+	}
+}
+
+func (storage *MapMemberTokenStorage) Store(ctx context.Context, token *authservice.MemberToken) error {
+	storage.mutex.Lock()
+	defer storage.mutex.Unlock()
+	storage.storage[string(token.TokenHash)] = token
+	return nil
+}
+
+func (storage *MapMemberTokenStorage) Consume(ctx context.Context, tokenHash []byte) (*authservice.MemberToken, error) {
+	storage.mutex.Lock()
+	defer storage.mutex.Unlock()
+	token, ok := storage.storage[string(tokenHash)]
+	if !ok {
+		return nil, authservice.ErrInvalidToken
+	}
+	delete(storage.storage, string(tokenHash))
+	return token, nil
+}
+
+func (storage *MapMemberTokenStorage) ClearExpired(ctx context.Context) error {
+	storage.mutex.Lock()
+	defer storage.mutex.Unlock()
+	for hash, token := range storage.storage {
+		if token.ExpiresAt.Before(time.Now()) {
+			delete(storage.storage, hash)
+		}
+	}
+	return nil
+}
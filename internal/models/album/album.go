@@ -15,6 +15,8 @@ type Album struct {
 	description string
 	plantIDs    uuid.UUIDs
 	ownerID     uuid.UUID
+	visibility  Visibility
+	shareToken  string
 	createdAt   time.Time
 	updatedAt   time.Time
 }
@@ -31,6 +33,7 @@ func CreateAlbum(id uuid.UUID,
 		description: description,
 		plantIDs:    plantIDs,
 		ownerID:     ownerID,
+		visibility:  VisibilityPrivate,
 		createdAt:   createdAt,
 		updatedAt:   updatedAt,
 	}
@@ -62,6 +65,15 @@ func (album *Album) Validate() error {
 	if album.createdAt.After(album.updatedAt) {
 		return fmt.Errorf("can't be created after update %v %v", album.createdAt, album.updatedAt)
 	}
+	if err := album.visibility.Validate(); err != nil {
+		return err
+	}
+	if album.visibility == VisibilityUnlisted && album.shareToken == "" {
+		return fmt.Errorf("unlisted album must have a share token")
+	}
+	if album.visibility != VisibilityUnlisted && album.shareToken != "" {
+		return fmt.Errorf("only unlisted album can have a share token")
+	}
 	if album.plantIDs == nil {
 		return fmt.Errorf("album plant ids cannot be nil")
 	}
@@ -104,6 +116,49 @@ func (album Album) GetOwnerID() uuid.UUID {
 	return album.ownerID
 }
 
+func (album Album) Visibility() Visibility {
+	return album.visibility
+}
+
+// ShareToken is the token of the share link, only unlisted albums have it.
+func (album Album) ShareToken() string {
+	return album.shareToken
+}
+
+// SetSharing restores the visibility and the share token of a stored album.
+func (album *Album) SetSharing(visibility Visibility, shareToken string) error {
+	prevVisibility, prevShareToken := album.visibility, album.shareToken
+	album.visibility, album.shareToken = visibility, shareToken
+	if err := album.Validate(); err != nil {
+		album.visibility, album.shareToken = prevVisibility, prevShareToken
+		return err
+	}
+	return nil
+}
+
+// UpdateVisibility makes a new share link when the album becomes unlisted,
+// any other visibility revokes the link.
+func (album *Album) UpdateVisibility(visibility Visibility) error {
+	if err := visibility.Validate(); err != nil {
+		return err
+	}
+	if visibility == album.visibility {
+		return nil
+	}
+	shareToken := ""
+	if visibility == VisibilityUnlisted {
+		var err error
+		shareToken, err = newShareToken()
+		if err != nil {
+			return fmt.Errorf("share token generation failed %w", err)
+		}
+	}
+	album.visibility = visibility
+	album.shareToken = shareToken
+	album.updatedAt = time.Now()
+	return nil
+}
+
 func (album *Album) UpdateName(name string) error {
 	album.name = name
 	album.updatedAt = time.Now()
@@ -146,4 +201,7 @@ type AlbumRepository interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	Get(ctx context.Context, id uuid.UUID) (*Album, error)
 	List(ctx context.Context, ownerID uuid.UUID) ([]*Album, error)
+	// GetByShareToken returns the unlisted album of the share link.
+	GetByShareToken(ctx context.Context, shareToken string) (*Album, error)
+	ListPublic(ctx context.Context) ([]*Album, error)
 }
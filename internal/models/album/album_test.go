@@ -228,4 +228,60 @@ func TestAlbum(t *testing.T) {
 		assert.Error(t, err)
 		assert.Equal(t, validPlantIDs, album.plantIDs)
 	})
+
+	t.Run("UpdateVisibility - ссылка для unlisted", func(t *testing.T) {
+		album, err := NewAlbum(validName, validDescription, validPlantIDs, validOwnerID)
+		require.NoError(t, err)
+		assert.Equal(t, VisibilityPrivate, album.Visibility())
+
+		require.NoError(t, album.UpdateVisibility(VisibilityUnlisted))
+		token := album.ShareToken()
+		assert.NotEmpty(t, token)
+		require.NoError(t, album.UpdateVisibility(VisibilityUnlisted))
+		assert.Equal(t, token, album.ShareToken())
+		require.NoError(t, album.Validate())
+
+		require.NoError(t, album.UpdateVisibility(VisibilityPublic))
+		assert.Empty(t, album.ShareToken())
+		require.NoError(t, album.UpdateVisibility(VisibilityUnlisted))
+		assert.NotEqual(t, token, album.ShareToken())
+	})
+
+	t.Run("UpdateVisibility - неизвестная видимость", func(t *testing.T) {
+		album, err := NewAlbum(validName, validDescription, validPlantIDs, validOwnerID)
+		require.NoError(t, err)
+
+		err = album.UpdateVisibility("friends")
+		require.ErrorIs(t, err, ErrInvalidVisibility)
+		assert.Equal(t, VisibilityPrivate, album.Visibility())
+	})
+
+	t.Run("SetSharing - ошибки валидации", func(t *testing.T) {
+		album, err := NewAlbum(validName, validDescription, validPlantIDs, validOwnerID)
+		require.NoError(t, err)
+
+		assert.Error(t, album.SetSharing(VisibilityUnlisted, ""))
+		assert.Error(t, album.SetSharing(VisibilityPrivate, "token"))
+		assert.Equal(t, VisibilityPrivate, album.Visibility())
+		require.NoError(t, album.SetSharing(VisibilityUnlisted, "token"))
+		assert.Equal(t, "token", album.ShareToken())
+	})
+
+	t.Run("Reader", func(t *testing.T) {
+		album, err := NewAlbum(validName, validDescription, validPlantIDs, validOwnerID)
+		require.NoError(t, err)
+		require.NoError(t, album.SetSharing(VisibilityUnlisted, "token"))
+
+		assert.True(t, Reader{ID: validOwnerID}.CanRead(album))
+		assert.True(t, Reader{ShareToken: "token"}.CanRead(album))
+		assert.True(t, Reader{ReadAny: true}.CanRead(album))
+		assert.False(t, Reader{}.CanRead(album))
+		assert.False(t, Reader{ID: uuid.New(), ShareToken: "other"}.CanRead(album))
+
+		require.NoError(t, album.SetSharing(VisibilityPrivate, ""))
+		assert.False(t, Reader{ShareToken: ""}.CanRead(album))
+
+		require.NoError(t, album.SetSharing(VisibilityPublic, ""))
+		assert.True(t, Reader{}.CanRead(album))
+	})
 }
@@ -6,3 +6,4 @@ var ErrPlantAlreadyInAlbum = errors.New("plant already in album")
 var ErrPlantNotFound = errors.New("plant not found")
 
 var ErrAlbumNotFound = errors.New("album not found")
+var ErrInvalidVisibility = errors.New("invalid album visibility")
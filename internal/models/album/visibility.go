@@ -0,0 +1,61 @@
+package album
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type Visibility string
+
+const (
+	// VisibilityPrivate albums are read only by the owner
+	VisibilityPrivate Visibility = "private"
+	// VisibilityUnlisted albums are read by anyone with the share link
+	VisibilityUnlisted Visibility = "unlisted"
+	// VisibilityPublic albums are read by anyone and listed
+	VisibilityPublic Visibility = "public"
+)
+
+const shareTokenLength = 24
+
+func (v Visibility) Validate() error {
+	switch v {
+	case VisibilityPrivate, VisibilityUnlisted, VisibilityPublic:
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidVisibility, v)
+	}
+}
+
+func newShareToken() (string, error) {
+	buf := make([]byte, shareTokenLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// Reader is who reads albums. Anyone reads public albums, the owner reads own albums,
+// a share link holder reads the unlisted album of the link and ReadAny reads every album.
+// The zero Reader reads only public albums.
+type Reader struct {
+	ID         uuid.UUID
+	ShareToken string
+	ReadAny    bool
+}
+
+func (r Reader) CanRead(album *Album) bool {
+	switch {
+	case r.ReadAny, album.visibility == VisibilityPublic:
+		return true
+	case r.ID != uuid.Nil && album.ownerID == r.ID:
+		return true
+	case album.visibility == VisibilityUnlisted:
+		return r.ShareToken != "" && r.ShareToken == album.shareToken
+	default:
+		return false
+	}
+}
@@ -39,6 +39,16 @@ func (a *Admin) Validate() error {
 	return nil
 }
 
+// IsAdmin reports whether the user is a site administrator.
+// Admins acting through a token need the admin scope.
+func IsAdmin(user User) bool {
+	if scoped, ok := user.(*ScopedUser); ok {
+		return scoped.scope == ScopeAdmin && IsAdmin(scoped.User)
+	}
+	_, ok := user.(*Admin)
+	return ok
+}
+
 func NewAdmin(login string, hashPassword []byte) (*Admin, error) {
 	id := uuid.NewSHA1(uuid.NameSpaceDNS, []byte(login))
 	return CreateAdmin(id, login, hashPassword)
@@ -72,6 +82,16 @@ func (a *Admin) HashedPassword() []byte {
 	return []byte(a.hashPassword)
 }
 
+func (a *Admin) UpdateHashedPassword(hashPassword []byte) error {
+	previousHashPassword := a.hashPassword
+	a.hashPassword = hashPassword
+	if err := a.Validate(); err != nil {
+		a.hashPassword = previousHashPassword
+		return err
+	}
+	return nil
+}
+
 func (a *Admin) IsAuthenticated() bool {
 	return true
 }
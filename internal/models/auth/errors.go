@@ -5,11 +5,16 @@ import (
 	"fmt"
 )
 
-var ErrUserNotFound = errors.New("user not found")
+var (
+	ErrUserNotFound = errors.New("user not found")
+	ErrRoleNotFound = errors.New("role not found")
+)
 
 var (
-	ErrBaseNoRights   = errors.New("user has no rights")
-	ErrNoAuthorRights = fmt.Errorf("%w: author", ErrBaseNoRights)
-	ErrNotAuthorized  = errors.New("not authorized")
-	ErrNoMemberRights = fmt.Errorf("%w: %w", ErrBaseNoRights, ErrNotAuthorized)
+	ErrBaseNoRights     = errors.New("user has no rights")
+	ErrNoAuthorRights   = fmt.Errorf("%w: author", ErrBaseNoRights)
+	ErrNotAuthorized    = errors.New("not authorized")
+	ErrNoMemberRights   = fmt.Errorf("%w: %w", ErrBaseNoRights, ErrNotAuthorized)
+	ErrNoAdminRights    = fmt.Errorf("%w: admin", ErrBaseNoRights)
+	ErrEmailNotVerified = fmt.Errorf("%w: email is not verified", ErrBaseNoRights)
 )
@@ -10,20 +10,24 @@ import (
 var _ User = (*Member)(nil)
 
 type Member struct {
-	id         uuid.UUID
-	name       string
-	email      string
-	hashPasswd []byte
-	createdAt  time.Time
+	id            uuid.UUID
+	name          string
+	email         string
+	hashPasswd    []byte
+	createdAt     time.Time
+	emailVerified bool
+	roles         []string
 }
 
+// CreateMember restores a member with verified email, use MarkEmailUnverified for members who haven't verified it yet.
 func CreateMember(id uuid.UUID, name string, email string, hashPasswd []byte, createdAt time.Time) (*Member, error) {
 	member := &Member{
-		id:         id,
-		name:       name,
-		email:      email,
-		hashPasswd: hashPasswd,
-		createdAt:  createdAt,
+		id:            id,
+		name:          name,
+		email:         email,
+		hashPasswd:    hashPasswd,
+		createdAt:     createdAt,
+		emailVerified: true,
 	}
 
 	if err := member.Validate(); err != nil {
@@ -55,10 +59,16 @@ func (m *Member) Validate() error {
 	return nil
 }
 
+// NewMember registers a member, the email stays unverified until the member confirms it.
 func NewMember(name, email string, hashPasswd []byte) (*Member, error) {
 	id := uuid.New()
 	createdAt := time.Now()
-	return CreateMember(id, name, email, hashPasswd, createdAt)
+	member, err := CreateMember(id, name, email, hashPasswd, createdAt)
+	if err != nil {
+		return nil, err
+	}
+	member.MarkEmailUnverified()
+	return member, nil
 }
 
 func (m *Member) HasAuthorRights() bool {
@@ -97,6 +107,27 @@ func (m *Member) CreatedAt() time.Time {
 	return m.createdAt
 }
 
+func (m *Member) EmailVerified() bool {
+	return m.emailVerified
+}
+
+func (m *Member) MarkEmailVerified() {
+	m.emailVerified = true
+}
+
+func (m *Member) MarkEmailUnverified() {
+	m.emailVerified = false
+}
+
+// Roles are the roles assigned to the member, the member and author roles come with the user type.
+func (m *Member) Roles() []string {
+	return m.roles
+}
+
+func (m *Member) SetRoles(roles []string) {
+	m.roles = roles
+}
+
 func (m *Member) UpdateName(name string) error {
 	previousName := m.name
 	m.name = name
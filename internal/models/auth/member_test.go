@@ -63,6 +63,25 @@ func TestMember(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotEqual(t, uuid.Nil, member.ID())
 		assert.False(t, member.createdAt.IsZero())
+		assert.False(t, member.EmailVerified())
+	})
+
+	t.Run("Подтверждение email", func(t *testing.T) {
+		member, err := CreateMember(validID, validName, validEmail, validHash, validTime)
+		require.NoError(t, err)
+		assert.True(t, member.EmailVerified())
+		assert.True(t, IsEmailVerified(member))
+
+		member.MarkEmailUnverified()
+		assert.False(t, member.EmailVerified())
+		assert.False(t, IsEmailVerified(member))
+
+		author, err := CreateAuthor(*member, validTime, true, time.Time{})
+		require.NoError(t, err)
+		assert.False(t, IsEmailVerified(author))
+
+		member.MarkEmailVerified()
+		assert.True(t, member.EmailVerified())
 	})
 
 	t.Run("Проверка прав", func(t *testing.T) {
@@ -0,0 +1,162 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// Permission is a named action that roles grant to users.
+type Permission string
+
+const (
+	PermPlantEdit      Permission = "plant:edit"
+	PermPostEdit       Permission = "post:edit"
+	PermPostModerate   Permission = "post:moderate"
+	PermCategoryManage Permission = "category:manage"
+	PermAlbumRead      Permission = "album:read"
+	PermAlbumManage    Permission = "album:manage"
+	PermAlbumReadAny   Permission = "album:read_any"
+	PermMemberManage   Permission = "member:manage"
+)
+
+var permissions = []Permission{
+	PermPlantEdit,
+	PermPostEdit,
+	PermPostModerate,
+	PermCategoryManage,
+	PermAlbumRead,
+	PermAlbumManage,
+	PermAlbumReadAny,
+	PermMemberManage,
+}
+
+func ParsePermission(permission string) (Permission, error) {
+	if slices.Contains(permissions, Permission(permission)) {
+		return Permission(permission), nil
+	}
+	return "", fmt.Errorf("unknown permission %q", permission)
+}
+
+// ReadOnly reports whether the permission only lets to read, read-only tokens keep such permissions.
+func (p Permission) ReadOnly() bool {
+	return p == PermAlbumRead || p == PermAlbumReadAny
+}
+
+// rightsError is the error of the built-in role that grants the permission,
+// so that callers handling the missing rights keep working.
+func (p Permission) rightsError() error {
+	switch p {
+	case PermAlbumRead, PermAlbumManage:
+		return ErrNoMemberRights
+	case PermPlantEdit, PermPostEdit, PermCategoryManage:
+		return ErrNoAuthorRights
+	default:
+		return ErrNoAdminRights
+	}
+}
+
+// NoPermissionError is returned when none of the user roles grants the permission.
+type NoPermissionError struct {
+	Permission Permission
+}
+
+func (e *NoPermissionError) Error() string {
+	return fmt.Sprintf("%v: no %s permission", e.Permission.rightsError(), e.Permission)
+}
+
+func (e *NoPermissionError) Unwrap() error {
+	return e.Permission.rightsError()
+}
+
+// Built-in roles. Members and authors get their role from the user type,
+// other roles are assigned to the users explicitly.
+const (
+	RoleMember    = "member"
+	RoleAuthor    = "author"
+	RoleModerator = "moderator"
+)
+
+type Role struct {
+	Name        string
+	Permissions []Permission
+}
+
+func (r Role) Grants(perm Permission) bool {
+	return slices.Contains(r.Permissions, perm)
+}
+
+// DefaultRoles are the built-in roles as they are seeded in the database.
+// No role manages members, only admins do.
+func DefaultRoles() []Role {
+	return []Role{
+		{Name: RoleMember, Permissions: []Permission{PermAlbumManage, PermAlbumRead}},
+		{Name: RoleAuthor, Permissions: []Permission{PermCategoryManage, PermPlantEdit, PermPostEdit}},
+		{Name: RoleModerator, Permissions: []Permission{PermAlbumReadAny, PermPostModerate}},
+	}
+}
+
+// Policy decides what users may do by the permissions of their roles.
+// Admins may do everything.
+type Policy struct {
+	roles map[string]Role
+}
+
+func NewPolicy(roles []Role) *Policy {
+	p := &Policy{roles: make(map[string]Role, len(roles))}
+	for _, role := range roles {
+		p.roles[role.Name] = role
+	}
+	return p
+}
+
+func DefaultPolicy() *Policy {
+	return NewPolicy(DefaultRoles())
+}
+
+// Can reports whether the user has the permission.
+// Users acting through a read-only token keep only read-only permissions.
+func (p *Policy) Can(user User, perm Permission) bool {
+	if scoped, ok := user.(*ScopedUser); ok && scoped.scope == ScopeReadOnly && !perm.ReadOnly() {
+		return false
+	}
+	if IsAdmin(user) {
+		return true
+	}
+	if scoped, ok := user.(*ScopedUser); ok {
+		user = scoped.User
+	}
+
+	if assigned, ok := user.(interface{ Roles() []string }); ok {
+		for _, name := range assigned.Roles() {
+			if p.roles[name].Grants(perm) {
+				return true
+			}
+		}
+	}
+	// Rights are only asked for when the role grants the permission
+	if p.roles[RoleMember].Grants(perm) && user.HasMemberRights() {
+		return true
+	}
+	if p.roles[RoleAuthor].Grants(perm) && user.HasAuthorRights() {
+		return true
+	}
+	return false
+}
+
+// Authorize is Can returning the error to report to the user.
+func (p *Policy) Authorize(user User, perm Permission) error {
+	if p.Can(user, perm) {
+		return nil
+	}
+	return &NoPermissionError{Permission: perm}
+}
+
+// RoleRepository keeps the roles with their permissions and the roles assigned to users.
+type RoleRepository interface {
+	ListRoles(ctx context.Context) ([]Role, error)
+	AssignRole(ctx context.Context, userID uuid.UUID, role string) error
+	UnassignRole(ctx context.Context, userID uuid.UUID, role string) error
+}
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicy(t *testing.T) {
+	member, err := CreateMember(uuid.New(), "John Doe", "john@example.com", []byte("hash"), time.Now())
+	require.NoError(t, err)
+	author, err := CreateAuthor(*member, time.Now(), true, time.Time{})
+	require.NoError(t, err)
+	moderator, err := CreateMember(uuid.New(), "Jane Doe", "jane@example.com", []byte("hash"), time.Now())
+	require.NoError(t, err)
+	moderator.SetRoles([]string{RoleModerator})
+	admin, err := NewAdmin("admin", []byte("hash"))
+	require.NoError(t, err)
+
+	policy := DefaultPolicy()
+
+	t.Run("Roles from user type", func(t *testing.T) {
+		assert.True(t, policy.Can(member, PermAlbumManage))
+		assert.False(t, policy.Can(member, PermPostEdit))
+		assert.True(t, policy.Can(author, PermPostEdit))
+		assert.True(t, policy.Can(author, PermAlbumManage))
+		assert.False(t, policy.Can(author, PermPostModerate))
+		assert.False(t, policy.Can(NewNoAuthUser(), PermAlbumManage))
+	})
+
+	t.Run("Assigned roles", func(t *testing.T) {
+		assert.True(t, policy.Can(moderator, PermPostModerate))
+		assert.True(t, policy.Can(moderator, PermAlbumReadAny))
+		assert.False(t, policy.Can(moderator, PermPlantEdit))
+		assert.False(t, policy.Can(moderator, PermMemberManage))
+		assert.False(t, policy.Can(author, PermMemberManage))
+	})
+
+	t.Run("Author without rights", func(t *testing.T) {
+		revoked, err := CreateAuthor(*member, time.Now().Add(-time.Hour), false, time.Now())
+		require.NoError(t, err)
+		assert.False(t, policy.Can(revoked, PermPostEdit))
+		assert.True(t, policy.Can(revoked, PermAlbumManage))
+	})
+
+	t.Run("Admin may do everything", func(t *testing.T) {
+		for _, perm := range permissions {
+			assert.True(t, policy.Can(admin, perm))
+		}
+		assert.False(t, policy.Can(NewScopedUser(admin, ScopeAuthor), PermPostModerate))
+	})
+
+	t.Run("Read-only scope keeps read-only permissions", func(t *testing.T) {
+		assert.False(t, policy.Can(NewScopedUser(author, ScopeReadOnly), PermPostEdit))
+		assert.True(t, policy.Can(NewScopedUser(moderator, ScopeReadOnly), PermAlbumReadAny))
+		assert.True(t, policy.Can(NewScopedUser(member, ScopeReadOnly), PermAlbumRead))
+		assert.False(t, policy.Can(NewScopedUser(member, ScopeReadOnly), PermAlbumManage))
+		assert.True(t, policy.Can(NewScopedUser(moderator, ScopeAuthor), PermPostModerate))
+	})
+
+	t.Run("Roles come from the policy", func(t *testing.T) {
+		custom := NewPolicy([]Role{{Name: RoleModerator, Permissions: []Permission{PermAlbumReadAny}}})
+		assert.False(t, custom.Can(moderator, PermPostModerate))
+		assert.False(t, custom.Can(author, PermPostEdit))
+	})
+
+	t.Run("Missing permission error", func(t *testing.T) {
+		assert.NoError(t, policy.Authorize(author, PermPlantEdit))
+		assert.ErrorIs(t, policy.Authorize(member, PermPlantEdit), ErrNoAuthorRights)
+		assert.ErrorIs(t, policy.Authorize(NewNoAuthUser(), PermAlbumManage), ErrNotAuthorized)
+		assert.ErrorIs(t, policy.Authorize(member, PermPostModerate), ErrBaseNoRights)
+	})
+}
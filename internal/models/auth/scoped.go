@@ -0,0 +1,55 @@
+package auth
+
+import "fmt"
+
+// TokenScope limits what a personal API token can do on behalf of its owner.
+type TokenScope string
+
+const (
+	ScopeReadOnly TokenScope = "read-only"
+	ScopeAuthor   TokenScope = "author"
+	ScopeAdmin    TokenScope = "admin"
+)
+
+func ParseTokenScope(scope string) (TokenScope, error) {
+	switch TokenScope(scope) {
+	case ScopeReadOnly, ScopeAuthor, ScopeAdmin:
+		return TokenScope(scope), nil
+	}
+	return "", fmt.Errorf("unknown token scope %q", scope)
+}
+
+var _ User = (*ScopedUser)(nil)
+
+// ScopedUser is a user acting through a personal API token.
+// The scope only takes rights away, it never grants what the user doesn't have.
+type ScopedUser struct {
+	User
+	scope TokenScope
+}
+
+func NewScopedUser(user User, scope TokenScope) *ScopedUser {
+	return &ScopedUser{User: user, scope: scope}
+}
+
+func (u *ScopedUser) Scope() TokenScope {
+	return u.scope
+}
+
+func (u *ScopedUser) HasAuthorRights() bool {
+	return u.scope != ScopeReadOnly && u.User.HasAuthorRights()
+}
+
+func (u *ScopedUser) HasMemberRights() bool {
+	return u.scope != ScopeReadOnly && u.User.HasMemberRights()
+}
+
+func (u *ScopedUser) EmailVerified() bool {
+	return IsEmailVerified(u.User)
+}
+
+// IsScoped reports whether the user acts through a personal API token.
+func IsScoped(user User) bool {
+	_, ok := user.(*ScopedUser)
+	return ok
+}
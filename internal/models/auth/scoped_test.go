@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScopedUser(t *testing.T) {
+	member, err := CreateMember(uuid.New(), "John Doe", "john@example.com", []byte("hash"), time.Now())
+	require.NoError(t, err)
+	author, err := CreateAuthor(*member, time.Now(), true, time.Time{})
+	require.NoError(t, err)
+	admin, err := NewAdmin("admin", []byte("hash"))
+	require.NoError(t, err)
+
+	t.Run("ParseTokenScope", func(t *testing.T) {
+		for _, scope := range []string{"read-only", "author", "admin"} {
+			parsed, err := ParseTokenScope(scope)
+			require.NoError(t, err)
+			assert.Equal(t, TokenScope(scope), parsed)
+		}
+		_, err := ParseTokenScope("root")
+		assert.Error(t, err)
+	})
+
+	t.Run("Read-only scope takes rights away", func(t *testing.T) {
+		scoped := NewScopedUser(author, ScopeReadOnly)
+		assert.True(t, scoped.IsAuthenticated())
+		assert.Equal(t, author.ID(), scoped.ID())
+		assert.False(t, scoped.HasMemberRights())
+		assert.False(t, scoped.HasAuthorRights())
+	})
+
+	t.Run("Author scope keeps user rights", func(t *testing.T) {
+		assert.True(t, NewScopedUser(author, ScopeAuthor).HasAuthorRights())
+		assert.False(t, NewScopedUser(member, ScopeAuthor).HasAuthorRights())
+		assert.True(t, NewScopedUser(member, ScopeAuthor).HasMemberRights())
+	})
+
+	t.Run("Admin needs admin scope", func(t *testing.T) {
+		assert.True(t, IsAdmin(NewScopedUser(admin, ScopeAdmin)))
+		assert.False(t, IsAdmin(NewScopedUser(admin, ScopeAuthor)))
+		assert.False(t, IsAdmin(NewScopedUser(member, ScopeAdmin)))
+	})
+
+	t.Run("Email verification passes through", func(t *testing.T) {
+		assert.True(t, IsEmailVerified(NewScopedUser(member, ScopeAuthor)))
+		member.MarkEmailUnverified()
+		assert.False(t, IsEmailVerified(NewScopedUser(member, ScopeAuthor)))
+	})
+}
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -15,6 +16,16 @@ type User interface {
 	Username() string
 }
 
+// IsEmailVerified reports whether the user has confirmed the email.
+// Admins are created by the operator with the admin command and are trusted.
+func IsEmailVerified(user User) bool {
+	if IsAdmin(user) {
+		return true
+	}
+	verified, ok := user.(interface{ EmailVerified() bool })
+	return ok && verified.EmailVerified()
+}
+
 type AuthRepository interface {
 	Get(ctx context.Context, id uuid.UUID) (User, error)
 	Create(ctx context.Context, mem *Member) (User, error)
@@ -22,3 +33,15 @@ type AuthRepository interface {
 	GetByName(ctx context.Context, name string) (User, error)
 	GetByEmail(ctx context.Context, email string) (User, error)
 }
+
+// AuthorRightsChange is an entry of the author rights history of a member.
+type AuthorRightsChange struct {
+	HasRights bool
+	ChangedAt time.Time
+}
+
+// MemberRepository lists registered members and their author rights history for administration.
+type MemberRepository interface {
+	List(ctx context.Context, offset, limit int) ([]User, error)
+	AuthorRightsHistory(ctx context.Context, id uuid.UUID) ([]AuthorRightsChange, error)
+}
@@ -3,5 +3,7 @@ package plant
 import "errors"
 
 var (
-	ErrPlantNotFound = errors.New("plant not found")
+	ErrPlantNotFound      = errors.New("plant not found")
+	ErrPlantPhotoNotFound = errors.New("plant photo not found")
+	ErrInvalidPhotoOrder  = errors.New("photo order must list every plant photo exactly once")
 )
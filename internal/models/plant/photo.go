@@ -48,6 +48,10 @@ func (pphoto *PlantPhoto) ID() uuid.UUID {
 	return pphoto.id
 }
 
+func (pphoto *PlantPhoto) UpdateDescription(description string) {
+	pphoto.description = description
+}
+
 func NewPlantPhoto(fileID uuid.UUID, description string) (*PlantPhoto, error) {
 	return CreatePlantPhoto(uuid.New(), fileID, description)
 }
@@ -87,6 +91,36 @@ func (pp *PlantPhotos) Remove(photo *PlantPhoto) error {
 	return nil
 }
 
+func (pp PlantPhotos) Get(photoID uuid.UUID) (*PlantPhoto, error) {
+	index := slices.IndexFunc(pp.photos, func(e PlantPhoto) bool {
+		return e.id == photoID
+	})
+	if index == -1 {
+		return nil, ErrPlantPhotoNotFound
+	}
+	photo := pp.photos[index]
+	return &photo, nil
+}
+
+// Reorder places the photos in the given order, every photo must be listed exactly once.
+func (pp *PlantPhotos) Reorder(photoIDs []uuid.UUID) error {
+	if len(photoIDs) != len(pp.photos) {
+		return ErrInvalidPhotoOrder
+	}
+	reordered := make([]PlantPhoto, 0, len(pp.photos))
+	for _, id := range photoIDs {
+		index := slices.IndexFunc(pp.photos, func(e PlantPhoto) bool {
+			return e.id == id
+		})
+		if index == -1 || slices.ContainsFunc(reordered, func(e PlantPhoto) bool { return e.id == id }) {
+			return ErrInvalidPhotoOrder
+		}
+		reordered = append(reordered, pp.photos[index])
+	}
+	pp.photos = reordered
+	return nil
+}
+
 func (pp PlantPhotos) Iterate(iterFunc func(e PlantPhoto) error) error {
 	for _, photo := range pp.photos {
 		if err := iterFunc(photo); err != nil {
@@ -133,6 +133,36 @@ func TestPlantPhotos(t *testing.T) {
 		assert.Equal(t, 1, pp.Len())
 	})
 
+	t.Run("Get - успешное получение", func(t *testing.T) {
+		pp := NewPlantPhotos()
+		require.NoError(t, pp.Add(photo1))
+		photo, err := pp.Get(photo1.ID())
+		require.NoError(t, err)
+		assert.Equal(t, photo1.FileID(), photo.FileID())
+
+		_, err = pp.Get(photo2.ID())
+		assert.ErrorIs(t, err, ErrPlantPhotoNotFound)
+	})
+
+	t.Run("Reorder - успешная перестановка", func(t *testing.T) {
+		pp := NewPlantPhotos()
+		require.NoError(t, pp.Add(photo1))
+		require.NoError(t, pp.Add(photo2))
+		require.NoError(t, pp.Reorder([]uuid.UUID{photo2.ID(), photo1.ID()}))
+		assert.Equal(t, photo2.ID(), pp.photos[0].ID())
+		assert.Equal(t, photo1.ID(), pp.photos[1].ID())
+	})
+
+	t.Run("Reorder - неверный порядок", func(t *testing.T) {
+		pp := NewPlantPhotos()
+		require.NoError(t, pp.Add(photo1))
+		require.NoError(t, pp.Add(photo2))
+		assert.ErrorIs(t, pp.Reorder([]uuid.UUID{photo1.ID()}), ErrInvalidPhotoOrder)
+		assert.ErrorIs(t, pp.Reorder([]uuid.UUID{photo1.ID(), photo1.ID()}), ErrInvalidPhotoOrder)
+		assert.ErrorIs(t, pp.Reorder([]uuid.UUID{photo1.ID(), uuid.New()}), ErrInvalidPhotoOrder)
+		assert.Equal(t, photo1.ID(), pp.photos[0].ID())
+	})
+
 	t.Run("Iterate", func(t *testing.T) {
 		pp := NewPlantPhotos()
 		require.NoError(t, pp.Add(photo1))
@@ -1,8 +1,8 @@
 package plant
 
 import (
-	"errors"
 	"fmt"
+	"slices"
 
 	"time"
 
@@ -153,20 +153,39 @@ func (p *Plant) Validate() error {
 }
 
 func (p *Plant) DeletePhoto(photoID uuid.UUID) error {
-	doneErr := errors.New("done")
+	photo, err := p.photos.Get(photoID)
+	if err != nil {
+		return err
+	}
+	if err := p.photos.Remove(photo); err != nil {
+		return err
+	}
+	p.updatedAt = time.Now()
+	return nil
+}
+
+func (p *Plant) UpdatePhotoDescription(photoID uuid.UUID, description string) error {
+	if _, err := p.photos.Get(photoID); err != nil {
+		return err
+	}
 	err := p.photos.IterateUpdate(func(e *PlantPhoto) error {
 		if e.ID() == photoID {
-			p.photos.Remove(e)
-			p.updatedAt = time.Now()
-			return doneErr
+			e.UpdateDescription(description)
 		}
 		return nil
 	})
-	if errors.Is(err, doneErr) {
-		return nil
-	} else if err != nil {
+	if err != nil {
 		return err
 	}
+	p.updatedAt = time.Now()
+	return nil
+}
+
+func (p *Plant) ReorderPhotos(photoIDs []uuid.UUID) error {
+	if err := p.photos.Reorder(photoIDs); err != nil {
+		return err
+	}
+	p.updatedAt = time.Now()
 	return nil
 }
 
@@ -205,3 +224,20 @@ func (p *Plant) UpdateMainPhotoID(mainPhotoID uuid.UUID) error {
 	p.updatedAt = time.Now()
 	return nil
 }
+
+// PromotePhoto makes the gallery photo the main photo of the plant.
+// The former main photo takes the place of the promoted one in the gallery,
+// so no file is left unreferenced.
+func (p *Plant) PromotePhoto(photoID uuid.UUID) error {
+	index := slices.IndexFunc(p.photos.photos, func(e PlantPhoto) bool {
+		return e.id == photoID
+	})
+	if index == -1 {
+		return ErrPlantPhotoNotFound
+	}
+	photo := &p.photos.photos[index]
+	photo.fileID, p.mainPhotoID = p.mainPhotoID, photo.fileID
+	photo.description = ""
+	p.updatedAt = time.Now()
+	return nil
+}
@@ -213,6 +213,96 @@ func TestPlant(t *testing.T) {
 		require.Error(t, err)
 	})
 
+	t.Run("PromotePhoto - успешная замена главного фото", func(t *testing.T) {
+		galleryPhoto, _ := CreatePlantPhoto(uuid.New(), uuid.New(), "gallery photo")
+		photos := NewPlantPhotos()
+		_ = photos.Add(galleryPhoto)
+		plant, _ := CreatePlant(
+			validID,
+			"Test Plant",
+			"Testus Plantus",
+			"Test description",
+			validFileID,
+			*photos,
+			"Test Category",
+			validSpec,
+			validTime,
+			validTime,
+		)
+
+		require.NoError(t, plant.PromotePhoto(galleryPhoto.ID()))
+		assert.Equal(t, galleryPhoto.FileID(), plant.MainPhotoID())
+		gallery := plant.GetPhotos()
+		require.Equal(t, 1, gallery.Len())
+		assert.Equal(t, validFileID, gallery.photos[0].FileID())
+		assert.Equal(t, galleryPhoto.ID(), gallery.photos[0].ID())
+	})
+
+	t.Run("PromotePhoto - фото не найдено", func(t *testing.T) {
+		plant, _ := CreatePlant(
+			validID,
+			"Test Plant",
+			"Testus Plantus",
+			"Test description",
+			validFileID,
+			*NewPlantPhotos(),
+			"Test Category",
+			validSpec,
+			validTime,
+			validTime,
+		)
+
+		err := plant.PromotePhoto(uuid.New())
+		require.ErrorIs(t, err, ErrPlantPhotoNotFound)
+		assert.Equal(t, validFileID, plant.MainPhotoID())
+	})
+
+	t.Run("DeletePhoto - успешное удаление", func(t *testing.T) {
+		galleryPhoto, _ := CreatePlantPhoto(uuid.New(), uuid.New(), "gallery photo")
+		photos := NewPlantPhotos()
+		_ = photos.Add(galleryPhoto)
+		plant, _ := CreatePlant(
+			validID,
+			"Test Plant",
+			"Testus Plantus",
+			"Test description",
+			validFileID,
+			*photos,
+			"Test Category",
+			validSpec,
+			validTime,
+			validTime,
+		)
+
+		require.NoError(t, plant.DeletePhoto(galleryPhoto.ID()))
+		assert.Equal(t, 0, plant.GetPhotos().Len())
+		assert.ErrorIs(t, plant.DeletePhoto(galleryPhoto.ID()), ErrPlantPhotoNotFound)
+	})
+
+	t.Run("UpdatePhotoDescription - успешное обновление", func(t *testing.T) {
+		galleryPhoto, _ := CreatePlantPhoto(uuid.New(), uuid.New(), "gallery photo")
+		photos := NewPlantPhotos()
+		_ = photos.Add(galleryPhoto)
+		plant, _ := CreatePlant(
+			validID,
+			"Test Plant",
+			"Testus Plantus",
+			"Test description",
+			validFileID,
+			*photos,
+			"Test Category",
+			validSpec,
+			validTime,
+			validTime,
+		)
+
+		require.NoError(t, plant.UpdatePhotoDescription(galleryPhoto.ID(), "new description"))
+		photo, err := plant.GetPhotos().Get(galleryPhoto.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "new description", photo.Description())
+		assert.ErrorIs(t, plant.UpdatePhotoDescription(uuid.New(), "description"), ErrPlantPhotoNotFound)
+	})
+
 	t.Run("Getters", func(t *testing.T) {
 		plant, _ := CreatePlant(
 			validID,
@@ -13,7 +13,7 @@ const (
 
 func (c *ContentFormat) Validate() error {
 	switch *c {
-	case ContentTypePlainText:
+	case ContentTypePlainText, ContentTypeMarkdown:
 		return nil
 	default:
 		if strings.HasPrefix(string(*c), string(ContentTypeWithPlant)) {
@@ -54,8 +54,13 @@ func (c *Content) Validate() error {
 	switch c.ContentType {
 	case ContentTypePlainText: // для plain text не нужны проверки
 		return nil
+	case ContentTypeMarkdown:
+		return validateMarkdown(c.Text)
 	}
 	if CheckContentWithPlant(c) {
+		if IsMarkdown(c) {
+			return validateMarkdown(c.Text)
+		}
 		return nil
 	}
 	return fmt.Errorf("unsupported content type: %s", c.ContentType)
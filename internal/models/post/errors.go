@@ -1,8 +1,46 @@
 package post
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
 
 var (
 	ErrPostNotFound        = errors.New("post not found")
 	ErrContentParsingError = errors.New("content parsing error")
+	ErrPhotoPlaceNotFound  = errors.New("no post photo with such place number")
+	ErrPostPhotoNotFound   = errors.New("post photo not found")
+	ErrMaximumPhotoCount   = errors.New("maximum number of photos exceeded")
+	ErrInvalidPhotoOrder   = errors.New("invalid photo order")
 )
+
+// UnknownPlantError is returned by plant parsers when a plant referenced by name
+// can't be resolved. Suggestions hold names of the most similar plants.
+type UnknownPlantError struct {
+	Name        string
+	Suggestions []string
+	cause       error
+}
+
+func NewUnknownPlantError(name string, suggestions []string, cause error) *UnknownPlantError {
+	return &UnknownPlantError{Name: name, Suggestions: suggestions, cause: cause}
+}
+
+func (e *UnknownPlantError) Error() string {
+	msg := fmt.Sprintf("%v: unknown plant %q", ErrContentParsingError, e.Name)
+	if e.cause != nil {
+		msg = fmt.Sprintf("%s: %v", msg, e.cause)
+	}
+	if len(e.Suggestions) > 0 {
+		msg = fmt.Sprintf("%s (did you mean: %s?)", msg, strings.Join(e.Suggestions, ", "))
+	}
+	return msg
+}
+
+func (e *UnknownPlantError) Unwrap() []error {
+	if e.cause == nil {
+		return []error{ErrContentParsingError}
+	}
+	return []error{ErrContentParsingError, e.cause}
+}
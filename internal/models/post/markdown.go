@@ -0,0 +1,63 @@
+package post
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	ContentTypeMarkdown ContentFormat = "markdown"
+	// MarkdownPlantParserType is the with_plant suffix for markdown content with plant references.
+	MarkdownPlantParserType = "markdown"
+)
+
+var (
+	ErrRawHTMLInContent  = errors.New("raw html is not allowed in content")
+	ErrUnsafeLinkContent = errors.New("unsafe link in content")
+)
+
+var (
+	// rawHTMLPattern matches html comments, doctype declarations and tag-like text,
+	// the tag name decides whether it is html, so that text like "a <b and c> d" passes.
+	rawHTMLPattern = regexp.MustCompile(`(?i)<!--|<!doctype|</?([a-z][a-z0-9]*)(?:\s[^<>]*)?/?>`)
+	// unsafeLinkPattern matches link targets with schemes able to execute code in a browser.
+	unsafeLinkPattern = regexp.MustCompile(`(?i)\]\(\s*(javascript|vbscript|data)\s*:`)
+)
+
+// htmlTags are the names of html elements, other names in angle brackets are plain text.
+var htmlTags = map[string]struct{}{}
+
+func init() {
+	for _, tag := range strings.Fields(`
+		a abbr address area article aside audio b base bdi bdo blockquote body br button
+		canvas caption cite code col colgroup data datalist dd del details dfn dialog div dl dt
+		em embed fieldset figcaption figure footer form frame frameset h1 h2 h3 h4 h5 h6 head header hr html
+		i iframe img input ins kbd label legend li link main map mark math menu meta meter nav noscript
+		object ol optgroup option output p param picture pre progress q rp rt ruby
+		s samp script section select slot small source span strong style sub summary sup svg
+		table tbody td template textarea tfoot th thead time title tr track u ul var video wbr`) {
+		htmlTags[tag] = struct{}{}
+	}
+}
+
+// IsMarkdown reports whether content text is markdown, with or without plant references.
+func IsMarkdown(content *Content) bool {
+	return content.ContentType == ContentTypeMarkdown || PlantParserType(content) == MarkdownPlantParserType
+}
+
+// validateMarkdown rejects markdown that embeds raw html or script links.
+// Markdown is rendered to html on the server, so everything else gets escaped.
+func validateMarkdown(text string) error {
+	for _, match := range rawHTMLPattern.FindAllStringSubmatch(text, -1) {
+		// Comments and doctype declarations have no tag name
+		if _, ok := htmlTags[strings.ToLower(match[1])]; ok || match[1] == "" {
+			return fmt.Errorf("%w: %q", ErrRawHTMLInContent, match[0])
+		}
+	}
+	if match := unsafeLinkPattern.FindString(text); match != "" {
+		return fmt.Errorf("%w: %q", ErrUnsafeLinkContent, strings.TrimLeft(match, "]( "))
+	}
+	return nil
+}
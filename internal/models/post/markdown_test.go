@@ -0,0 +1,51 @@
+package post
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkdownContent(t *testing.T) {
+	testCases := []struct {
+		name        string
+		text        string
+		format      ContentFormat
+		expectedErr error
+	}{
+		{"Обычный markdown", "# Title\n\n**bold** and [link](https://example.com)", ContentTypeMarkdown, nil},
+		{"Сравнения не считаются html", "height < 2m and width > 1m", ContentTypeMarkdown, nil},
+		{"Слово data не считается ссылкой", "data: 5 trees", ContentTypeMarkdown, nil},
+		{"Автоссылка не считается html", "see <https://example.com> and <mailto:a@example.com>", ContentTypeMarkdown, nil},
+		{"Текст в угловых скобках", "x <y and z> w", ContentTypeMarkdown, nil},
+		{"Тег в верхнем регистре", "<SCRIPT>alert(1)</SCRIPT>", ContentTypeMarkdown, ErrRawHTMLInContent},
+		{"doctype", "<!DOCTYPE html>", ContentTypeMarkdown, ErrRawHTMLInContent},
+		{"Тег script", "text <script>alert(1)</script>", ContentTypeMarkdown, ErrRawHTMLInContent},
+		{"Тег с атрибутами", "<img src=x onerror=alert(1)>", ContentTypeMarkdown, ErrRawHTMLInContent},
+		{"html комментарий", "<!-- hidden -->", ContentTypeMarkdown, ErrRawHTMLInContent},
+		{"javascript ссылка", "[click](javascript:alert(1))", ContentTypeMarkdown, ErrUnsafeLinkContent},
+		{"data ссылка", "[click]( DATA:text/html;base64,xxx)", ContentTypeMarkdown, ErrUnsafeLinkContent},
+		{"markdown с растениями", "see [rose](plant:rose) <b>", WithPlantContentType(MarkdownPlantParserType), ErrRawHTMLInContent},
+		{"plain text не проверяется", "<b>bold</b>", ContentTypePlainText, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			content, err := NewContent(tc.text, tc.format)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.text, content.Text)
+		})
+	}
+}
+
+func TestIsMarkdown(t *testing.T) {
+	assert.True(t, IsMarkdown(&Content{ContentType: ContentTypeMarkdown}))
+	assert.True(t, IsMarkdown(&Content{ContentType: WithPlantContentType(MarkdownPlantParserType)}))
+	assert.False(t, IsMarkdown(&Content{ContentType: WithPlantContentType("latex")}))
+	assert.False(t, IsMarkdown(&Content{ContentType: ContentTypePlainText}))
+}
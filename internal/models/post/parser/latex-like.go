@@ -49,17 +49,11 @@ func (p *LatexLikePlantParser) Parse(text string) ([]uuid.UUID, string, error) {
 
 		content := strings.TrimSpace(text[openBraceIdx:closeBraceIdx])
 
-		var plantID uuid.UUID
-		if id, err := uuid.Parse(content); err == nil {
-			plantID = id
-		} else {
-			plant, err := p.plantGetter.GetPlantByName(content)
-			if err != nil {
-				return nil, "", fmt.Errorf("%w: %v", post.ErrContentParsingError, err)
-			}
-			plantID = plant.ID()
-			content = plantID.String() // Change name to UUID
+		plantID, err := resolvePlant(p.plantGetter, content)
+		if err != nil {
+			return nil, "", err
 		}
+		content = plantID.String() // Change name to UUID
 
 		result.WriteString(tagStart)
 		result.WriteString(content)
@@ -72,11 +66,8 @@ func (p *LatexLikePlantParser) Parse(text string) ([]uuid.UUID, string, error) {
 	result.WriteString(text[lastPos:])
 
 	// Check plant existence
-	if len(plantIDs) > 0 {
-		_, err := p.plantGetter.GetPlants(plantIDs)
-		if err != nil {
-			return nil, "", fmt.Errorf("%w: %v", post.ErrContentParsingError, err)
-		}
+	if err := checkPlants(p.plantGetter, plantIDs); err != nil {
+		return nil, "", err
 	}
 
 	return plantIDs, result.String(), nil
@@ -2,6 +2,7 @@ package parser_test
 
 import (
 	"PlantSite/internal/models/plant"
+	"PlantSite/internal/models/post"
 	"PlantSite/internal/models/post/parser"
 	"fmt"
 	"testing"
@@ -32,6 +33,14 @@ func (m *MockPlantGetter) GetPlantByName(name string) (*plant.Plant, error) {
 	return args.Get(0).(*plant.Plant), args.Error(1)
 }
 
+func (m *MockPlantGetter) SuggestPlants(name string, limit int) ([]*plant.Plant, error) {
+	args := m.Called(name, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*plant.Plant), args.Error(1)
+}
+
 func TestLatexLikeParser(t *testing.T) {
 	testID1 := uuid.New()
 	testID2 := uuid.New()
@@ -120,6 +129,7 @@ func TestLatexLikeParser(t *testing.T) {
 			expectedPlantIDs: []uuid.UUID{},
 			mockSetup: func(m *MockPlantGetter) {
 				m.On("GetPlantByName", "invalid-uuid").Return(nil, fmt.Errorf("not found"))
+				m.On("SuggestPlants", "invalid-uuid", parser.MaximumPlantSuggestions).Return(nil, nil)
 			},
 			expectError: true,
 		},
@@ -152,6 +162,39 @@ func TestLatexLikeParser(t *testing.T) {
 	}
 }
 
+func TestLatexLikeParser_UnknownPlantSuggestions(t *testing.T) {
+	testSpec, err := plant.NewConiferousSpecification(1, 1, 10, plant.DryMoisture, plant.Light, plant.HeavySoil, 9)
+	require.NoError(t, err)
+	testPlant, err := plant.CreatePlant(
+		uuid.New(),
+		"rose",
+		"rosa",
+		"rose plant",
+		uuid.New(),
+		plant.PlantPhotos{},
+		plant.ConiferousCategory,
+		testSpec,
+		time.Now(),
+		time.Now(),
+	)
+	require.NoError(t, err)
+
+	mockGetter := new(MockPlantGetter)
+	mockGetter.On("GetPlantByName", "rosse").Return(nil, plant.ErrPlantNotFound)
+	mockGetter.On("SuggestPlants", "rosse", parser.MaximumPlantSuggestions).Return([]*plant.Plant{testPlant}, nil)
+
+	_, _, err = parser.NewLatexLikePlantParser(mockGetter).Parse("\\plant{rosse}")
+	require.Error(t, err)
+	require.ErrorIs(t, err, post.ErrContentParsingError)
+	require.ErrorIs(t, err, plant.ErrPlantNotFound)
+
+	var unknown *post.UnknownPlantError
+	require.ErrorAs(t, err, &unknown)
+	require.Equal(t, "rosse", unknown.Name)
+	require.Equal(t, []string{"rose"}, unknown.Suggestions)
+	mockGetter.AssertExpectations(t)
+}
+
 func TestLatexLikeParser_Suffix(t *testing.T) {
 	parser := parser.NewLatexLikePlantParser(nil)
 	require.Equal(t, "latex", parser.Suffix())
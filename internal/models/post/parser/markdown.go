@@ -0,0 +1,77 @@
+package parser
+
+import (
+	"PlantSite/internal/models/post"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MarkdownPlantParserType = post.MarkdownPlantParserType
+	// MarkdownPlantScheme is the link scheme of plant references in markdown.
+	MarkdownPlantScheme = "plant:"
+)
+
+type MarkdownPlantParser struct {
+	plantGetter PlantGetter
+}
+
+func NewMarkdownPlantParser(plantGetter PlantGetter) *MarkdownPlantParser {
+	return &MarkdownPlantParser{
+		plantGetter: plantGetter,
+	}
+}
+
+// Plants are referenced with markdown links, by id or by name:
+//
+//	....[our roses](plant:id1)... [Rosa canina](plant:Rosa canina)...
+func (p *MarkdownPlantParser) Parse(text string) ([]uuid.UUID, string, error) {
+	plantIDs := make([]uuid.UUID, 0)
+	const refStart = "](" + MarkdownPlantScheme
+	var result strings.Builder
+	lastPos := 0
+
+	for {
+		startIdx := strings.Index(text[lastPos:], refStart)
+		if startIdx == -1 {
+			break
+		}
+		startIdx += lastPos
+
+		openIdx := startIdx + len(refStart)
+		result.WriteString(text[lastPos:openIdx])
+
+		closeIdx := strings.Index(text[openIdx:], ")")
+		if closeIdx == -1 {
+			return nil, "", fmt.Errorf("%w: ) not found", post.ErrContentParsingError)
+		}
+		closeIdx += openIdx
+
+		ref := strings.TrimSpace(text[openIdx:closeIdx])
+		plantID, err := resolvePlant(p.plantGetter, ref)
+		if err != nil {
+			return nil, "", err
+		}
+
+		result.WriteString(plantID.String()) // Change name to UUID
+		result.WriteString(")")
+
+		plantIDs = append(plantIDs, plantID)
+		lastPos = closeIdx + 1
+	}
+
+	result.WriteString(text[lastPos:])
+
+	// Check plant existence
+	if err := checkPlants(p.plantGetter, plantIDs); err != nil {
+		return nil, "", err
+	}
+
+	return plantIDs, result.String(), nil
+}
+
+func (p *MarkdownPlantParser) Suffix() string {
+	return MarkdownPlantParserType
+}
@@ -0,0 +1,119 @@
+package parser_test
+
+import (
+	"PlantSite/internal/models/plant"
+	"PlantSite/internal/models/post"
+	"PlantSite/internal/models/post/parser"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkdownParser(t *testing.T) {
+	testID1 := uuid.New()
+	testID2 := uuid.New()
+
+	testSpec, err := plant.NewConiferousSpecification(1, 1, 10, plant.DryMoisture, plant.Light, plant.HeavySoil, 9)
+	require.NoError(t, err)
+
+	testPlant, err := plant.CreatePlant(
+		testID2,
+		"rose",
+		"rosa",
+		"rose plant",
+		uuid.New(),
+		plant.PlantPhotos{},
+		plant.ConiferousCategory,
+		testSpec,
+		time.Now(),
+		time.Now(),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		text             string
+		expectedText     string
+		expectedPlantIDs []uuid.UUID
+		mockSetup        func(*MockPlantGetter)
+		expectError      error
+	}{
+		{
+			name:             "no plants",
+			text:             "# Title\n\n[a link](https://example.com)",
+			expectedText:     "# Title\n\n[a link](https://example.com)",
+			expectedPlantIDs: []uuid.UUID{},
+			mockSetup:        func(m *MockPlantGetter) {},
+		},
+		{
+			name:             "plant by UUID",
+			text:             "look at [this](plant:" + testID1.String() + ")",
+			expectedText:     "look at [this](plant:" + testID1.String() + ")",
+			expectedPlantIDs: []uuid.UUID{testID1},
+			mockSetup: func(m *MockPlantGetter) {
+				m.On("GetPlants", []uuid.UUID{testID1}).Return([]*plant.Plant{testPlant}, nil)
+			},
+		},
+		{
+			name:             "plant by name",
+			text:             "**[Roses](plant: rose )** are red",
+			expectedText:     "**[Roses](plant:" + testID2.String() + ")** are red",
+			expectedPlantIDs: []uuid.UUID{testID2},
+			mockSetup: func(m *MockPlantGetter) {
+				m.On("GetPlantByName", "rose").Return(testPlant, nil)
+				m.On("GetPlants", []uuid.UUID{testID2}).Return([]*plant.Plant{testPlant}, nil)
+			},
+		},
+		{
+			name: "unknown plant",
+			text: "[Roses](plant:rosse)",
+			mockSetup: func(m *MockPlantGetter) {
+				m.On("GetPlantByName", "rosse").Return(nil, plant.ErrPlantNotFound)
+				m.On("SuggestPlants", "rosse", parser.MaximumPlantSuggestions).Return([]*plant.Plant{testPlant}, nil)
+			},
+			expectError: post.ErrContentParsingError,
+		},
+		{
+			name:        "unclosed plant link",
+			text:        "[Roses](plant:rose",
+			mockSetup:   func(m *MockPlantGetter) {},
+			expectError: post.ErrContentParsingError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGetter := new(MockPlantGetter)
+			tt.mockSetup(mockGetter)
+
+			parser := parser.NewMarkdownPlantParser(mockGetter)
+			plantIDs, resultText, err := parser.Parse(tt.text)
+
+			if tt.expectError != nil {
+				require.ErrorIs(t, err, tt.expectError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedText, resultText)
+			require.Equal(t, tt.expectedPlantIDs, plantIDs)
+			mockGetter.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetParser(t *testing.T) {
+	markdown, err := post.NewContent("text", post.WithPlantContentType(parser.MarkdownPlantParserType))
+	require.NoError(t, err)
+	p, err := parser.GetParser(markdown, nil)
+	require.NoError(t, err)
+	require.Equal(t, parser.MarkdownPlantParserType, p.Suffix())
+
+	latex, err := post.NewContent("text", post.WithPlantContentType(parser.LatexLikePlantParserType))
+	require.NoError(t, err)
+	p, err = parser.GetParser(latex, nil)
+	require.NoError(t, err)
+	require.Equal(t, parser.LatexLikePlantParserType, p.Suffix())
+}
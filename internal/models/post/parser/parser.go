@@ -8,18 +8,62 @@ import (
 	"github.com/google/uuid"
 )
 
+// MaximumPlantSuggestions limits how many similar plants are offered for an unknown plant name.
+const MaximumPlantSuggestions = 5
+
 type PlantGetter interface {
 	GetPlants(uuids []uuid.UUID) ([]*plant.Plant, error)
 	GetPlantByName(name string) (*plant.Plant, error)
+	// SuggestPlants returns at most limit plants with names similar to the given one,
+	// the closest first.
+	SuggestPlants(name string, limit int) ([]*plant.Plant, error)
 }
 
 func GetParser(content *post.Content, plantGetter PlantGetter) (post.ContentPlantParser, error) {
 	parser := post.PlantParserType(content)
 	switch parser {
-	case "latex":
+	case LatexLikePlantParserType:
 		return NewLatexLikePlantParser(plantGetter), nil
+	case MarkdownPlantParserType:
+		return NewMarkdownPlantParser(plantGetter), nil
 	default:
 		return nil, fmt.Errorf("unsupported plant parser: %s", parser)
 	}
 
 }
+
+// resolvePlant returns ID of the plant referenced either by ID or by name.
+func resolvePlant(plantGetter PlantGetter, ref string) (uuid.UUID, error) {
+	if id, err := uuid.Parse(ref); err == nil {
+		return id, nil
+	}
+	plant, err := plantGetter.GetPlantByName(ref)
+	if err != nil {
+		return uuid.Nil, unknownPlant(plantGetter, ref, err)
+	}
+	return plant.ID(), nil
+}
+
+// unknownPlant builds an error for an unresolved plant name,
+// suggesting similar plant names if there are any.
+func unknownPlant(plantGetter PlantGetter, name string, cause error) error {
+	suggestions := make([]string, 0)
+	plants, err := plantGetter.SuggestPlants(name, MaximumPlantSuggestions)
+	if err == nil {
+		for _, plnt := range plants {
+			suggestions = append(suggestions, plnt.GetName())
+		}
+	}
+	return post.NewUnknownPlantError(name, suggestions, cause)
+}
+
+// checkPlants verifies that all referenced plants exist.
+func checkPlants(plantGetter PlantGetter, plantIDs []uuid.UUID) error {
+	if len(plantIDs) == 0 {
+		return nil
+	}
+	if _, err := plantGetter.GetPlants(plantIDs); err != nil {
+		return fmt.Errorf("%w: %v", post.ErrContentParsingError, err)
+	}
+	return nil
+}
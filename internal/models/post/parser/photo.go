@@ -0,0 +1,95 @@
+package parser
+
+import (
+	"PlantSite/internal/models/post"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PhotoMarkerStart opens a photo placement marker, e.g. \photo{3}
+// puts the photo with place number 3 at this point of the text.
+const PhotoMarkerStart = "\\photo{"
+
+// PhotoPlaces returns place numbers of all photo markers in the text, in order of appearance.
+func PhotoPlaces(text string) ([]int, error) {
+	places := make([]int, 0)
+	err := scanPhotoMarkers(text, func(_, _ int, place int) error {
+		places = append(places, place)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return places, nil
+}
+
+// RenumberPhotoPlaces rewrites the photo markers of the text after the post photos moved,
+// places maps old place numbers to the new ones. Markers of places missing from the map
+// refer to removed photos and are reported.
+func RenumberPhotoPlaces(text string, places map[int]int) (string, error) {
+	var result strings.Builder
+	lastPos := 0
+	err := scanPhotoMarkers(text, func(start, end int, place int) error {
+		newPlace, ok := places[place]
+		if !ok {
+			return fmt.Errorf("%w: %w: %d", post.ErrContentParsingError, post.ErrPhotoPlaceNotFound, place)
+		}
+		result.WriteString(text[lastPos:start])
+		result.WriteString(PhotoMarkerStart)
+		result.WriteString(strconv.Itoa(newPlace))
+		result.WriteString("}")
+		lastPos = end
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	result.WriteString(text[lastPos:])
+	return result.String(), nil
+}
+
+// scanPhotoMarkers calls fn for every photo marker of the text with the marker bounds.
+func scanPhotoMarkers(text string, fn func(start, end int, place int) error) error {
+	lastPos := 0
+	for {
+		startIdx := strings.Index(text[lastPos:], PhotoMarkerStart)
+		if startIdx == -1 {
+			return nil
+		}
+		startIdx += lastPos
+		openBraceIdx := startIdx + len(PhotoMarkerStart)
+		closeBraceIdx := strings.Index(text[openBraceIdx:], "}")
+		if closeBraceIdx == -1 {
+			return fmt.Errorf("%w: } not found", post.ErrContentParsingError)
+		}
+		closeBraceIdx += openBraceIdx
+
+		place, err := strconv.Atoi(strings.TrimSpace(text[openBraceIdx:closeBraceIdx]))
+		if err != nil {
+			return fmt.Errorf("%w: invalid photo place number %q", post.ErrContentParsingError, text[openBraceIdx:closeBraceIdx])
+		}
+		if err := fn(startIdx, closeBraceIdx+1, place); err != nil {
+			return err
+		}
+		lastPos = closeBraceIdx + 1
+	}
+}
+
+// ValidatePhotoPlaces checks that every photo marker in the text refers to one of the post photos.
+func ValidatePhotoPlaces(text string, photos post.PostPhotos) error {
+	places, err := PhotoPlaces(text)
+	if err != nil {
+		return err
+	}
+	existing := make(map[int]struct{}, photos.Len())
+	for _, photo := range photos.List() {
+		existing[photo.PlaceNumber()] = struct{}{}
+	}
+	for _, place := range places {
+		if _, ok := existing[place]; !ok {
+			return fmt.Errorf("%w: %w: %d", post.ErrContentParsingError, post.ErrPhotoPlaceNotFound, place)
+		}
+	}
+	return nil
+}
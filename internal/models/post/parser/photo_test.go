@@ -0,0 +1,83 @@
+package parser_test
+
+import (
+	"PlantSite/internal/models/post"
+	"PlantSite/internal/models/post/parser"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPhotoPlaces(t *testing.T) {
+	tests := []struct {
+		name           string
+		text           string
+		expectedPlaces []int
+		expectError    bool
+	}{
+		{"no markers", "just text", []int{}, false},
+		{"markers", "first \\photo{2} then \\photo{ 1 } and \\plant{rose}", []int{2, 1}, false},
+		{"unclosed marker", "\\photo{2", nil, true},
+		{"not a number", "\\photo{two}", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			places, err := parser.PhotoPlaces(tt.text)
+			if tt.expectError {
+				require.ErrorIs(t, err, post.ErrContentParsingError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedPlaces, places)
+		})
+	}
+}
+
+func TestValidatePhotoPlaces(t *testing.T) {
+	photos := post.NewPostPhotos()
+	for i := 1; i <= 2; i++ {
+		photo, err := post.NewPostPhoto(uuid.New(), i)
+		require.NoError(t, err)
+		require.NoError(t, photos.Add(photo))
+	}
+
+	require.NoError(t, parser.ValidatePhotoPlaces("\\photo{1} text \\photo{2}", *photos))
+	require.NoError(t, parser.ValidatePhotoPlaces("no photos inline", *photos))
+
+	err := parser.ValidatePhotoPlaces("\\photo{3}", *photos)
+	require.ErrorIs(t, err, post.ErrContentParsingError)
+	require.ErrorIs(t, err, post.ErrPhotoPlaceNotFound)
+
+	err = parser.ValidatePhotoPlaces("\\photo{1}", *post.NewPostPhotos())
+	require.ErrorIs(t, err, post.ErrPhotoPlaceNotFound)
+}
+
+func TestRenumberPhotoPlaces(t *testing.T) {
+	tests := []struct {
+		name         string
+		text         string
+		places       map[int]int
+		expectedText string
+		expectError  bool
+	}{
+		{"no markers", "just text", map[int]int{}, "just text", false},
+		{"moved", "\\photo{1} and \\photo{ 3 } and \\photo{2}", map[int]int{1: 3, 2: 1, 3: 2}, "\\photo{3} and \\photo{2} and \\photo{1}", false},
+		{"shifted by removal", "text \\photo{3}", map[int]int{2: 1, 3: 2}, "text \\photo{2}", false},
+		{"removed photo", "text \\photo{1}", map[int]int{2: 1, 3: 2}, "", true},
+		{"unclosed marker", "\\photo{2", map[int]int{2: 2}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, err := parser.RenumberPhotoPlaces(tt.text, tt.places)
+			if tt.expectError {
+				require.ErrorIs(t, err, post.ErrContentParsingError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedText, text)
+		})
+	}
+}
@@ -89,7 +89,7 @@ func (pp *PostPhotos) RebalancePositions() {
 
 func (pp *PostPhotos) Add(photo *PostPhoto) error {
 	if len(pp.photos) >= MaximumPhotoPerPostCount {
-		return fmt.Errorf("maximum number of photos exceeded")
+		return ErrMaximumPhotoCount
 	}
 	if err := photo.Validate(); err != nil {
 		return err
@@ -119,7 +119,40 @@ func (pp *PostPhotos) Remove(photoID uuid.UUID) error {
 			return nil
 		}
 	}
-	return fmt.Errorf("photo not found")
+	return ErrPostPhotoNotFound
+}
+
+func (pp *PostPhotos) Get(photoID uuid.UUID) (*PostPhoto, error) {
+	for _, ph := range pp.photos {
+		if ph.ID() == photoID {
+			return &ph, nil
+		}
+	}
+	return nil, ErrPostPhotoNotFound
+}
+
+// Reorder places photos in the given order, every photo must be listed exactly once.
+func (pp *PostPhotos) Reorder(photoIDs []uuid.UUID) error {
+	if len(photoIDs) != len(pp.photos) {
+		return fmt.Errorf("%w: expected %d photos, got %d", ErrInvalidPhotoOrder, len(pp.photos), len(photoIDs))
+	}
+	places := make(map[uuid.UUID]int, len(photoIDs))
+	for i, id := range photoIDs {
+		if _, exists := places[id]; exists {
+			return fmt.Errorf("%w: duplicate photo %s", ErrInvalidPhotoOrder, id)
+		}
+		places[id] = i + 1
+	}
+	for _, ph := range pp.photos {
+		if _, ok := places[ph.ID()]; !ok {
+			return fmt.Errorf("%w: photo %s is missing", ErrInvalidPhotoOrder, ph.ID())
+		}
+	}
+	for i := range pp.photos {
+		pp.photos[i].placeNumber = places[pp.photos[i].ID()]
+	}
+	pp.RebalancePositions()
+	return nil
 }
 
 func (pp *PostPhotos) Clear() error {
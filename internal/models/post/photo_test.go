@@ -109,6 +109,24 @@ func TestPostPhotos(t *testing.T) {
 		})
 	})
 
+	t.Run("Reorder", func(t *testing.T) {
+		t.Run("Success", func(t *testing.T) {
+			pp := &PostPhotos{photos: []PostPhoto{*photo1, *photo2, *photo3}}
+			assert.NoError(t, pp.Reorder([]uuid.UUID{photo3.ID(), photo1.ID(), photo2.ID()}))
+			list := pp.List()
+			assert.Equal(t, []uuid.UUID{photo3.ID(), photo1.ID(), photo2.ID()}, []uuid.UUID{list[0].ID(), list[1].ID(), list[2].ID()})
+			assert.Equal(t, []int{1, 2, 3}, []int{list[0].PlaceNumber(), list[1].PlaceNumber(), list[2].PlaceNumber()})
+		})
+
+		t.Run("Invalid order", func(t *testing.T) {
+			pp := &PostPhotos{photos: []PostPhoto{*photo1, *photo2}}
+			assert.ErrorIs(t, pp.Reorder([]uuid.UUID{photo1.ID()}), ErrInvalidPhotoOrder)
+			assert.ErrorIs(t, pp.Reorder([]uuid.UUID{photo1.ID(), photo1.ID()}), ErrInvalidPhotoOrder)
+			assert.ErrorIs(t, pp.Reorder([]uuid.UUID{photo1.ID(), photo3.ID()}), ErrInvalidPhotoOrder)
+			assert.Equal(t, photo1.ID(), pp.List()[0].ID())
+		})
+	})
+
 	t.Run("RebalancePositions", func(t *testing.T) {
 		pp := &PostPhotos{photos: []PostPhoto{
 			{placeNumber: 3},
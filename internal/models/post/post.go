@@ -194,6 +194,14 @@ func (p *Post) ClearPhotos() {
 	p.photos.Clear()
 }
 
+func (p *Post) RemovePhoto(photoID uuid.UUID) error {
+	return p.photos.Remove(photoID)
+}
+
+func (p *Post) ReorderPhotos(photoIDs []uuid.UUID) error {
+	return p.photos.Reorder(photoIDs)
+}
+
 type PostRepository interface {
 	Create(ctx context.Context, post *Post) (*Post, error)
 	Update(ctx context.Context, id uuid.UUID, updateFn func(*Post) (*Post, error)) (*Post, error)
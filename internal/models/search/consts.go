@@ -15,6 +15,8 @@ const (
 	PlantWinterHardinessFilterID = "PlantWinterHardinessFilter"
 	PlantFloweringPeriodFilterID = "PlantFloweringPeriodFilter"
 	PlantAlbumFilterID           = "PlantAlbumFilter"
+	PlantTextFilterID            = "PlantTextFilter"
+	PlantSimilarNameFilterID     = "PlantSimilarNameFilter"
 )
 
 const (
@@ -22,4 +24,14 @@ const (
 	PostTitleFilterID         = "PostTitleFilter"
 	PostTitleContainsFilterID = "PostTitleContainsFilter"
 	PostTagFilterID           = "PostTagFilter"
+	PostTextFilterID          = "PostTextFilter"
+)
+
+const (
+	SortByName      SortKey = "name"
+	SortByTitle     SortKey = "title"
+	SortByCreatedAt SortKey = "created_at"
+	SortByUpdatedAt SortKey = "updated_at"
+	SortByHeightM   SortKey = "height_m"
+	SortByRelevance SortKey = "relevance"
 )
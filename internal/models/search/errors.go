@@ -0,0 +1,8 @@
+package search
+
+import "errors"
+
+var (
+	ErrInvalidPage    = errors.New("invalid page")
+	ErrInvalidSortKey = errors.New("invalid sort key")
+)
@@ -0,0 +1,63 @@
+package search
+
+import (
+	"fmt"
+	"slices"
+)
+
+type SortKey string
+
+var (
+	PlantSortKeys = []SortKey{SortByName, SortByCreatedAt, SortByUpdatedAt, SortByHeightM, SortByRelevance}
+	PostSortKeys  = []SortKey{SortByTitle, SortByCreatedAt, SortByUpdatedAt, SortByRelevance}
+)
+
+type Sort struct {
+	Key  SortKey
+	Desc bool
+}
+
+// NewSort checks that the key is allowed. Relevance has only one direction,
+// the most relevant results go first.
+func NewSort(key SortKey, desc bool, allowed []SortKey) (Sort, error) {
+	if !slices.Contains(allowed, key) {
+		return Sort{}, fmt.Errorf("%w: %s", ErrInvalidSortKey, key)
+	}
+	if key == SortByRelevance && desc {
+		return Sort{}, fmt.Errorf("%w: descending %s", ErrInvalidSortKey, key)
+	}
+	return Sort{Key: key, Desc: desc}, nil
+}
+
+// Page describes a window of search results.
+// Zero limit means that all results are returned.
+type Page struct {
+	Limit  int
+	Offset int
+}
+
+func NewPage(limit, offset int) (Page, error) {
+	if limit < 0 {
+		return Page{}, fmt.Errorf("%w: limit must be non-negative", ErrInvalidPage)
+	}
+	if offset < 0 {
+		return Page{}, fmt.Errorf("%w: offset must be non-negative", ErrInvalidPage)
+	}
+	return Page{Limit: limit, Offset: offset}, nil
+}
+
+func (p Page) Unbounded() bool {
+	return p.Limit == 0
+}
+
+// Next returns the page following p, if there are results left after fetched ones.
+func (p Page) Next(fetched, total int) (Page, bool) {
+	if p.Unbounded() || fetched == 0 {
+		return Page{}, false
+	}
+	next := Page{Limit: p.Limit, Offset: p.Offset + fetched}
+	if next.Offset >= total {
+		return Page{}, false
+	}
+	return next, true
+}
@@ -0,0 +1,63 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPage(t *testing.T) {
+	t.Run("NewPage", func(t *testing.T) {
+		page, err := NewPage(10, 20)
+		require.NoError(t, err)
+		assert.Equal(t, Page{Limit: 10, Offset: 20}, page)
+
+		_, err = NewPage(-1, 0)
+		assert.ErrorIs(t, err, ErrInvalidPage)
+
+		_, err = NewPage(10, -1)
+		assert.ErrorIs(t, err, ErrInvalidPage)
+	})
+
+	t.Run("Next", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			page    Page
+			fetched int
+			total   int
+			next    Page
+			ok      bool
+		}{
+			{"First page of many", Page{Limit: 10, Offset: 0}, 10, 25, Page{Limit: 10, Offset: 10}, true},
+			{"Last full page", Page{Limit: 10, Offset: 10}, 10, 20, Page{}, false},
+			{"Last partial page", Page{Limit: 10, Offset: 20}, 5, 25, Page{}, false},
+			{"Nothing fetched", Page{Limit: 10, Offset: 30}, 0, 25, Page{}, false},
+			{"Unbounded", Page{}, 25, 25, Page{}, false},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				next, ok := tt.page.Next(tt.fetched, tt.total)
+				assert.Equal(t, tt.ok, ok)
+				assert.Equal(t, tt.next, next)
+			})
+		}
+	})
+}
+
+func TestSearchSort(t *testing.T) {
+	plantSearch := NewPlantSearch()
+	assert.Equal(t, SortByName, plantSearch.Sort().Key)
+	require.NoError(t, plantSearch.SetSort(Sort{Key: SortByHeightM, Desc: true}))
+	assert.Equal(t, Sort{Key: SortByHeightM, Desc: true}, plantSearch.Sort())
+	assert.ErrorIs(t, plantSearch.SetSort(Sort{Key: SortByTitle}), ErrInvalidSortKey)
+
+	postSearch := NewPostSearch()
+	assert.Equal(t, Sort{Key: SortByCreatedAt, Desc: true}, postSearch.Sort())
+	require.NoError(t, postSearch.SetSort(Sort{Key: SortByTitle}))
+	assert.ErrorIs(t, postSearch.SetSort(Sort{Key: SortByHeightM}), ErrInvalidSortKey)
+
+	require.NoError(t, plantSearch.SetSort(Sort{Key: SortByRelevance}))
+	assert.ErrorIs(t, plantSearch.SetSort(Sort{Key: SortByRelevance, Desc: true}), ErrInvalidSortKey)
+	assert.ErrorIs(t, postSearch.SetSort(Sort{Key: SortByRelevance, Desc: true}), ErrInvalidSortKey)
+}
@@ -4,11 +4,14 @@ import "PlantSite/internal/models/plant"
 
 type PlantSearch struct {
 	filters []PlantFilter
+	page    Page
+	sort    Sort
 }
 
 func NewPlantSearch() *PlantSearch {
 	return &PlantSearch{
 		filters: make([]PlantFilter, 0),
+		sort:    Sort{Key: SortByName, Desc: false},
 	}
 }
 
@@ -33,3 +36,23 @@ func (s *PlantSearch) Iterate(fn func(PlantFilter) error) error {
 	}
 	return nil
 }
+
+func (s *PlantSearch) SetPage(page Page) {
+	s.page = page
+}
+
+func (s *PlantSearch) Page() Page {
+	return s.page
+}
+
+func (s *PlantSearch) SetSort(sort Sort) error {
+	if _, err := NewSort(sort.Key, sort.Desc, PlantSortKeys); err != nil {
+		return err
+	}
+	s.sort = sort
+	return nil
+}
+
+func (s *PlantSearch) Sort() Sort {
+	return s.sort
+}
@@ -305,16 +305,19 @@ func (p *PlantFloweringPeriodFilter) Filter(pl *plant.Plant) bool {
 	return false
 }
 
+// PlantAlbumFilter matches plants of the album if the reader can read it,
+// the zero reader matches only plants of a public album.
 type PlantAlbumFilter struct {
 	AlbumID uuid.UUID
+	Reader  album.Reader
 	Albums  []*album.Album
 }
 
-func NewPlantAlbumFilter(albmID uuid.UUID, albms []*album.Album) *PlantAlbumFilter {
+func NewPlantAlbumFilter(albmID uuid.UUID, reader album.Reader, albms []*album.Album) *PlantAlbumFilter {
 	if albms == nil {
 		albms = make([]*album.Album, 0)
 	}
-	return &PlantAlbumFilter{AlbumID: albmID, Albums: albms}
+	return &PlantAlbumFilter{AlbumID: albmID, Reader: reader, Albums: albms}
 }
 
 var _ PlantFilter = &PlantAlbumFilter{}
@@ -329,7 +332,66 @@ func NewPlantAlnumFilter(albmID uuid.UUID) *PlantAlbumFilter {
 
 func (p *PlantAlbumFilter) Filter(pl *plant.Plant) bool {
 	for _, albm := range p.Albums {
-		if albm.ID() == p.AlbumID && slices.Contains(albm.PlantIDs(), pl.ID()) {
+		if albm.ID() == p.AlbumID && p.Reader.CanRead(albm) && slices.Contains(albm.PlantIDs(), pl.ID()) {
+			return true
+		}
+	}
+	return false
+}
+
+// PlantTextFilter is a full-text search over plant name, latin name and description.
+type PlantTextFilter struct {
+	Query string
+}
+
+var _ PlantFilter = &PlantTextFilter{}
+
+func NewPlantTextFilter(query string) *PlantTextFilter {
+	return &PlantTextFilter{Query: query}
+}
+
+func (p *PlantTextFilter) Identifier() string {
+	return PlantTextFilterID
+}
+
+func (p *PlantTextFilter) Filter(pl *plant.Plant) bool {
+	return containsAllWords(p.Query, pl.GetName(), pl.GetLatinName(), pl.GetDescription())
+}
+
+// containsAllWords approximates full-text matching for in-memory filtering:
+// every word of the query must appear in one of the texts.
+func containsAllWords(query string, texts ...string) bool {
+	joined := strings.ToLower(strings.Join(texts, " "))
+	words := strings.Fields(strings.ToLower(query))
+	if len(words) == 0 {
+		return false
+	}
+	for _, word := range words {
+		if !strings.Contains(joined, word) {
+			return false
+		}
+	}
+	return true
+}
+
+// PlantSimilarNameFilter is a typo-tolerant lookup by plant name or latin name.
+type PlantSimilarNameFilter struct {
+	Name string
+}
+
+var _ PlantFilter = &PlantSimilarNameFilter{}
+
+func NewPlantSimilarNameFilter(name string) *PlantSimilarNameFilter {
+	return &PlantSimilarNameFilter{Name: name}
+}
+
+func (p *PlantSimilarNameFilter) Identifier() string {
+	return PlantSimilarNameFilterID
+}
+
+func (p *PlantSimilarNameFilter) Filter(pl *plant.Plant) bool {
+	for _, name := range []string{pl.GetName(), pl.GetLatinName()} {
+		if NameSimilarity(p.Name, name) >= SimilarityThreshold || WordSimilarity(p.Name, name) >= WordSimilarityThreshold {
 			return true
 		}
 	}
@@ -1,6 +1,7 @@
 package search
 
 import (
+	"PlantSite/internal/models/album"
 	"PlantSite/internal/models/plant"
 	"testing"
 
@@ -36,6 +37,32 @@ func TestPlantFilters(t *testing.T) {
 	deciduousPlant, err := mockPlant("Oak", "Quercus robur", "deciduous", deciduousSpec)
 	require.NoError(t, err)
 
+	t.Run("PlantTextFilter", func(t *testing.T) {
+		filter := NewPlantTextFilter("pinus")
+		assert.True(t, filter.Filter(coniferousPlant))
+		assert.False(t, filter.Filter(deciduousPlant))
+
+		filter = NewPlantTextFilter("test oak")
+		assert.False(t, filter.Filter(coniferousPlant))
+		assert.True(t, filter.Filter(deciduousPlant))
+
+		filter = NewPlantTextFilter("   ")
+		assert.False(t, filter.Filter(coniferousPlant))
+	})
+
+	t.Run("PlantSimilarNameFilter", func(t *testing.T) {
+		filter := NewPlantSimilarNameFilter("Pinus silvestris")
+		assert.True(t, filter.Filter(coniferousPlant))
+		assert.False(t, filter.Filter(deciduousPlant))
+
+		filter = NewPlantSimilarNameFilter("quercus")
+		assert.False(t, filter.Filter(coniferousPlant))
+		assert.True(t, filter.Filter(deciduousPlant))
+
+		filter = NewPlantSimilarNameFilter("")
+		assert.False(t, filter.Filter(coniferousPlant))
+	})
+
 	t.Run("PlantNameFilter", func(t *testing.T) {
 		filter := NewPlantNameFilter("Pine")
 		assert.True(t, filter.Filter(coniferousPlant))
@@ -170,4 +197,29 @@ func TestPlantFilters(t *testing.T) {
 		filter = NewFloweringPeriodFilter([]plant.FloweringPeriod{plant.Summer})
 		assert.False(t, filter.Filter(deciduousPlant))
 	})
+
+	t.Run("PlantAlbumFilter", func(t *testing.T) {
+		ownerID := uuid.New()
+		albm, err := album.NewAlbum("Conifers", "", uuid.UUIDs{coniferousPlant.ID()}, ownerID)
+		require.NoError(t, err)
+		albms := []*album.Album{albm}
+
+		filter := NewPlantAlbumFilter(albm.ID(), album.Reader{ID: ownerID}, albms)
+		assert.True(t, filter.Filter(coniferousPlant))
+		assert.False(t, filter.Filter(deciduousPlant))
+
+		// Приватный альбом не виден другим
+		filter = NewPlantAlbumFilter(albm.ID(), album.Reader{ID: uuid.New()}, albms)
+		assert.False(t, filter.Filter(coniferousPlant))
+
+		require.NoError(t, albm.UpdateVisibility(album.VisibilityUnlisted))
+		filter = NewPlantAlbumFilter(albm.ID(), album.Reader{}, albms)
+		assert.False(t, filter.Filter(coniferousPlant))
+		filter = NewPlantAlbumFilter(albm.ID(), album.Reader{ShareToken: albm.ShareToken()}, albms)
+		assert.True(t, filter.Filter(coniferousPlant))
+
+		require.NoError(t, albm.UpdateVisibility(album.VisibilityPublic))
+		filter = NewPlantAlbumFilter(albm.ID(), album.Reader{}, albms)
+		assert.True(t, filter.Filter(coniferousPlant))
+	})
 }
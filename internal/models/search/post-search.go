@@ -4,11 +4,14 @@ import "PlantSite/internal/models/post"
 
 type PostSearch struct {
 	filters []PostFilter
+	page    Page
+	sort    Sort
 }
 
 func NewPostSearch() *PostSearch {
 	return &PostSearch{
 		filters: make([]PostFilter, 0),
+		sort:    Sort{Key: SortByCreatedAt, Desc: true},
 	}
 }
 
@@ -33,3 +36,23 @@ func (s *PostSearch) Iterate(fn func(PostFilter) error) error {
 	}
 	return nil
 }
+
+func (s *PostSearch) SetPage(page Page) {
+	s.page = page
+}
+
+func (s *PostSearch) Page() Page {
+	return s.page
+}
+
+func (s *PostSearch) SetSort(sort Sort) error {
+	if _, err := NewSort(sort.Key, sort.Desc, PostSortKeys); err != nil {
+		return err
+	}
+	s.sort = sort
+	return nil
+}
+
+func (s *PostSearch) Sort() Sort {
+	return s.sort
+}
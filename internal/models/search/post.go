@@ -89,3 +89,22 @@ func NewPostAuthorFilter(authorID uuid.UUID) *PostAuthorFilter {
 func (p *PostAuthorFilter) Filter(post *post.Post) bool {
 	return post.AuthorID() == p.AuthorID
 }
+
+// PostTextFilter is a full-text search over post title and body.
+type PostTextFilter struct {
+	Query string
+}
+
+var _ PostFilter = &PostTextFilter{}
+
+func NewPostTextFilter(query string) *PostTextFilter {
+	return &PostTextFilter{Query: query}
+}
+
+func (p *PostTextFilter) Identifier() string {
+	return PostTextFilterID
+}
+
+func (p *PostTextFilter) Filter(post *post.Post) bool {
+	return containsAllWords(p.Query, post.Title(), post.Content().Text)
+}
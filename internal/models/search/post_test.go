@@ -41,6 +41,16 @@ func TestPostFilters(t *testing.T) {
 		assert.False(t, filter.Filter(testPost3))
 	})
 
+	t.Run("PostTextFilter", func(t *testing.T) {
+		filter := NewPostTextFilter("first content")
+		assert.True(t, filter.Filter(testPost1))
+		assert.False(t, filter.Filter(testPost2))
+
+		filter = NewPostTextFilter("test")
+		assert.True(t, filter.Filter(testPost1))
+		assert.True(t, filter.Filter(testPost2))
+	})
+
 	t.Run("PostTitleContainsFilter", func(t *testing.T) {
 		tests := []struct {
 			name  string
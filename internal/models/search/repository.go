@@ -12,6 +12,8 @@ import (
 type SearchRepository interface {
 	SearchPosts(ctx context.Context, search *PostSearch) ([]*post.Post, error)
 	SearchPlants(ctx context.Context, search *PlantSearch) ([]*plant.Plant, error)
+	CountPosts(ctx context.Context, search *PostSearch) (int, error)
+	CountPlants(ctx context.Context, search *PlantSearch) (int, error)
 	GetPostByID(ctx context.Context, id uuid.UUID) (*post.Post, error)
 	GetPlantByID(ctx context.Context, id uuid.UUID) (*plant.Plant, error)
 	GetPostAuthors(ctx context.Context) ([]*auth.Author, error)
@@ -0,0 +1,73 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	// SimilarityThreshold mirrors the default pg_trgm.similarity_threshold used by %.
+	SimilarityThreshold = 0.3
+	// WordSimilarityThreshold mirrors the default pg_trgm.word_similarity_threshold used by <%.
+	WordSimilarityThreshold = 0.6
+)
+
+// trigramList splits text into pg_trgm style trigrams in the order of the text:
+// every word is lowercased and padded with two spaces in front and one behind.
+func trigramList(text string) []string {
+	list := make([]string, 0)
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		runes := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(runes); i++ {
+			list = append(list, string(runes[i:i+3]))
+		}
+	}
+	return list
+}
+
+func trigrams(text string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, t := range trigramList(text) {
+		set[t] = struct{}{}
+	}
+	return set
+}
+
+// jaccard is the share of trigrams both sets have in common, from 0 to 1.
+func jaccard(a, b map[string]struct{}) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	common := 0
+	for t := range a {
+		if _, ok := b[t]; ok {
+			common++
+		}
+	}
+	return float64(common) / float64(len(a)+len(b)-common)
+}
+
+// NameSimilarity is the pg_trgm similarity of the query and the name, from 0 to 1.
+func NameSimilarity(query, name string) float64 {
+	return jaccard(trigrams(query), trigrams(name))
+}
+
+// WordSimilarity is the pg_trgm word_similarity of the query to the name:
+// the best similarity of the query to a continuous part of the name, from 0 to 1.
+// A query that is a whole word of the name is always similar.
+func WordSimilarity(query, name string) float64 {
+	qt := trigrams(query)
+	list := trigramList(name)
+	best := 0.0
+	for start := range list {
+		part := make(map[string]struct{})
+		for _, t := range list[start:] {
+			part[t] = struct{}{}
+			best = max(best, jaccard(qt, part))
+		}
+	}
+	return best
+}
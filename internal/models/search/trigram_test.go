@@ -0,0 +1,21 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// The expected values are the ones of the pg_trgm documentation.
+func TestNameSimilarity(t *testing.T) {
+	assert.InDelta(t, 0.363636, NameSimilarity("word", "two words"), 1e-6)
+	assert.Equal(t, 1.0, NameSimilarity("Quercus", "quercus"))
+	assert.Zero(t, NameSimilarity("", "quercus"))
+}
+
+func TestWordSimilarity(t *testing.T) {
+	assert.InDelta(t, 0.8, WordSimilarity("word", "two words"), 1e-6)
+	assert.Equal(t, 1.0, WordSimilarity("quercus", "Quercus robur"))
+	assert.Less(t, WordSimilarity("in", "Pinus"), WordSimilarityThreshold)
+	assert.Zero(t, WordSimilarity("", "quercus"))
+}
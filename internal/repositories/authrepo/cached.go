@@ -0,0 +1,109 @@
+package authrepo
+
+import (
+	"PlantSite/internal/models/auth"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var _ auth.AuthRepository = (*CachedRepository)(nil)
+
+type cachedUser struct {
+	user      auth.User
+	expiresAt time.Time
+}
+
+// CachedRepository keeps users got by ID for a short time, every request of a user loads them.
+// Updates through the repository, like granted or revoked rights, drop the cached user at once,
+// changes made elsewhere, like by the admin command, are seen after the TTL.
+type CachedRepository struct {
+	auth  auth.AuthRepository
+	ttl   time.Duration
+	users map[uuid.UUID]cachedUser
+	// generation changes on every invalidation, so that a user loaded before it isn't cached
+	generation uint64
+	lastSweep  time.Time
+	mutex      sync.Mutex
+}
+
+func NewCachedRepository(authRepo auth.AuthRepository, ttl time.Duration) *CachedRepository {
+	if authRepo == nil {
+		panic("nil auth")
+	}
+	if ttl <= 0 {
+		panic("user cache ttl must be greater than 0")
+	}
+	return &CachedRepository{
+		auth:      authRepo,
+		ttl:       ttl,
+		users:     make(map[uuid.UUID]cachedUser),
+		lastSweep: time.Now(),
+		mutex:     sync.Mutex{},
+	}
+}
+
+func (r *CachedRepository) Get(ctx context.Context, id uuid.UUID) (auth.User, error) {
+	now := time.Now()
+	r.mutex.Lock()
+	cached, ok := r.users[id]
+	generation := r.generation
+	r.mutex.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.user, nil
+	}
+
+	user, err := r.auth.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.generation == generation {
+		r.users[id] = cachedUser{user: user, expiresAt: now.Add(r.ttl)}
+	}
+	r.sweep(now)
+	return user, nil
+}
+
+func (r *CachedRepository) GetByName(ctx context.Context, name string) (auth.User, error) {
+	return r.auth.GetByName(ctx, name)
+}
+
+func (r *CachedRepository) GetByEmail(ctx context.Context, email string) (auth.User, error) {
+	return r.auth.GetByEmail(ctx, email)
+}
+
+func (r *CachedRepository) Create(ctx context.Context, user *auth.Member) (auth.User, error) {
+	return r.auth.Create(ctx, user)
+}
+
+func (r *CachedRepository) Update(ctx context.Context, id uuid.UUID, updateFn func(auth.User) (auth.User, error)) (auth.User, error) {
+	// The update may have been applied even if it failed afterwards
+	defer r.Invalidate(id)
+	return r.auth.Update(ctx, id, updateFn)
+}
+
+// Invalidate drops the cached user, the next Get loads it from the repository.
+func (r *CachedRepository) Invalidate(id uuid.UUID) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	delete(r.users, id)
+	r.generation++
+}
+
+// sweep drops expired users once per TTL, so that users who left don't stay in memory.
+func (r *CachedRepository) sweep(now time.Time) {
+	if now.Sub(r.lastSweep) < r.ttl {
+		return
+	}
+	for id, cached := range r.users {
+		if !now.Before(cached.expiresAt) {
+			delete(r.users, id)
+		}
+	}
+	r.lastSweep = now
+}
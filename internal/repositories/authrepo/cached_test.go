@@ -0,0 +1,80 @@
+package authrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"PlantSite/internal/models/auth"
+	"PlantSite/internal/repositories/authrepo"
+	authmock "PlantSite/internal/services/auth-service/auth-mock"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedRepository(t *testing.T) {
+	ctx := context.Background()
+	member, err := auth.NewMember("test", "test@example.com", []byte("hash"))
+	require.NoError(t, err)
+
+	t.Run("CachedWithinTTL", func(t *testing.T) {
+		inner := new(authmock.MockAuthRepository)
+		inner.On("Get", ctx, member.ID()).Return(member, nil)
+		repo := authrepo.NewCachedRepository(inner, time.Minute)
+
+		for range 3 {
+			user, err := repo.Get(ctx, member.ID())
+			require.NoError(t, err)
+			assert.Equal(t, member.ID(), user.ID())
+		}
+		inner.AssertNumberOfCalls(t, "Get", 1)
+	})
+
+	t.Run("ExpiredAfterTTL", func(t *testing.T) {
+		inner := new(authmock.MockAuthRepository)
+		inner.On("Get", ctx, member.ID()).Return(member, nil)
+		repo := authrepo.NewCachedRepository(inner, time.Millisecond)
+
+		_, err := repo.Get(ctx, member.ID())
+		require.NoError(t, err)
+		time.Sleep(2 * time.Millisecond)
+		_, err = repo.Get(ctx, member.ID())
+		require.NoError(t, err)
+		inner.AssertNumberOfCalls(t, "Get", 2)
+	})
+
+	t.Run("ErrorsNotCached", func(t *testing.T) {
+		inner := new(authmock.MockAuthRepository)
+		inner.On("Get", ctx, member.ID()).Return(nil, auth.ErrUserNotFound)
+		repo := authrepo.NewCachedRepository(inner, time.Minute)
+
+		_, err := repo.Get(ctx, member.ID())
+		require.ErrorIs(t, err, auth.ErrUserNotFound)
+		_, err = repo.Get(ctx, member.ID())
+		require.ErrorIs(t, err, auth.ErrUserNotFound)
+		inner.AssertNumberOfCalls(t, "Get", 2)
+	})
+
+	t.Run("RightsGrantInvalidates", func(t *testing.T) {
+		inner := new(authmock.MockAuthRepository)
+		inner.On("Get", ctx, member.ID()).Return(member, nil).Once()
+		author, err := auth.CreateAuthor(*member, time.Now(), true, time.Time{})
+		require.NoError(t, err)
+		inner.On("Update", ctx, member.ID(), mock.Anything).Return(author, nil)
+		inner.On("Get", ctx, member.ID()).Return(author, nil).Once()
+		repo := authrepo.NewWithAdminRepository(authrepo.NewCachedRepository(inner, time.Minute))
+
+		user, err := repo.Get(ctx, member.ID())
+		require.NoError(t, err)
+		assert.False(t, user.HasAuthorRights())
+
+		_, err = repo.Update(ctx, member.ID(), func(user auth.User) (auth.User, error) { return author, nil })
+		require.NoError(t, err)
+
+		user, err = repo.Get(ctx, member.ID())
+		require.NoError(t, err)
+		assert.True(t, user.HasAuthorRights())
+	})
+}
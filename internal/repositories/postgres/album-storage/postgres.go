@@ -26,15 +26,51 @@ type Album struct {
 	Description string
 	OwnerID     uuid.UUID
 	PlantIDs    uuid.UUIDs
+	Visibility  string
+	ShareToken  string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
 
+// share_token is NULL for albums without a share link, so that the unique index allows many of them
+var albumColumns = []string{"id", "name", "description", "owner_id", "visibility", "COALESCE(share_token, '')", "created_at", "updated_at"}
+
+func shareTokenValue(alb *album.Album) any {
+	if alb.ShareToken() == "" {
+		return nil
+	}
+	return alb.ShareToken()
+}
+
+func (row *AlbumRow) scan(r sqdb.Row) error {
+	return r.Scan(&row.ID, &row.Name, &row.Description, &row.OwnerID, &row.Visibility, &row.ShareToken, &row.CreatedAt, &row.UpdatedAt)
+}
+
+func (row *AlbumRow) toAlbum(plantIDs uuid.UUIDs) (*album.Album, error) {
+	alb, err := album.CreateAlbum(
+		row.ID,
+		row.Name,
+		row.Description,
+		plantIDs,
+		row.OwnerID,
+		row.CreatedAt,
+		row.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := alb.SetSharing(album.Visibility(row.Visibility), row.ShareToken); err != nil {
+		return nil, err
+	}
+	return alb, nil
+}
+
 func (repo *PostgresAlbumRepository) Create(ctx context.Context, alb *album.Album) (*album.Album, error) {
 	err := repo.db.Transaction(ctx, func(tx sqdb.SquirrelQuirier) error {
 		_, err := tx.Insert(ctx, squirrel.Insert("album").
-			Columns("id", "name", "description", "owner_id", "created_at", "updated_at").
-			Values(alb.ID(), alb.Name(), alb.Description(), alb.GetOwnerID(), alb.CreatedAt(), alb.UpdatedAt()),
+			Columns("id", "name", "description", "owner_id", "visibility", "share_token", "created_at", "updated_at").
+			Values(alb.ID(), alb.Name(), alb.Description(), alb.GetOwnerID(), string(alb.Visibility()), shareTokenValue(alb),
+				alb.CreatedAt(), alb.UpdatedAt()),
 		)
 
 		if err != nil {
@@ -63,42 +99,44 @@ func (repo *PostgresAlbumRepository) Create(ctx context.Context, alb *album.Albu
 }
 
 func (repo *PostgresAlbumRepository) Get(ctx context.Context, id uuid.UUID) (*album.Album, error) {
-	var tmpAlbum Album
-	row, err := repo.db.QueryRow(ctx, squirrel.Select("id", "name", "description", "owner_id", "created_at", "updated_at").
+	alb, err := repo.getBy(ctx, squirrel.Eq{"id": id})
+	if err != nil {
+		return nil, fmt.Errorf("PostgresAlbumRepository.Get failed %w", err)
+	}
+	return alb, nil
+}
+
+func (repo *PostgresAlbumRepository) GetByShareToken(ctx context.Context, shareToken string) (*album.Album, error) {
+	alb, err := repo.getBy(ctx, squirrel.Eq{"share_token": shareToken, "visibility": string(album.VisibilityUnlisted)})
+	if err != nil {
+		return nil, fmt.Errorf("PostgresAlbumRepository.GetByShareToken failed %w", err)
+	}
+	return alb, nil
+}
+
+func (repo *PostgresAlbumRepository) getBy(ctx context.Context, where squirrel.Eq) (*album.Album, error) {
+	var tmpAlbum AlbumRow
+	row, err := repo.db.QueryRow(ctx, squirrel.Select(albumColumns...).
 		From("album").
-		Where(squirrel.Eq{"id": id}),
+		Where(where),
 	)
 	if errors.Is(err, sqdb.ErrNoRows) {
-		return nil, fmt.Errorf("PostgresAlbumRepository.Get failed %w", album.ErrAlbumNotFound)
+		return nil, album.ErrAlbumNotFound
 	} else if err != nil {
-		return nil, fmt.Errorf("PostgresAlbumRepository.Get failed %w", err)
+		return nil, err
 	}
-	err = row.Scan(&tmpAlbum.ID, &tmpAlbum.Name, &tmpAlbum.Description, &tmpAlbum.OwnerID, &tmpAlbum.CreatedAt, &tmpAlbum.UpdatedAt)
-
+	err = tmpAlbum.scan(row)
 	if errors.Is(err, sqdb.ErrNoRows) {
-		return nil, fmt.Errorf("PostgresAlbumRepository.Get failed %w", album.ErrAlbumNotFound)
+		return nil, album.ErrAlbumNotFound
 	} else if err != nil {
 		return nil, err
 	}
 
 	plantIDs, err := repo.fetchPlantIDs(ctx, tmpAlbum.ID)
 	if err != nil {
-		return nil, fmt.Errorf("PostgresAlbumRepository.Get failed %w", err)
-	}
-
-	alb, err := album.CreateAlbum(
-		tmpAlbum.ID,
-		tmpAlbum.Name,
-		tmpAlbum.Description,
-		plantIDs,
-		tmpAlbum.OwnerID,
-		tmpAlbum.CreatedAt,
-		tmpAlbum.UpdatedAt,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("PostgresAlbumRepository.Get failed %w", err)
+		return nil, err
 	}
-	return alb, nil
+	return tmpAlbum.toAlbum(plantIDs)
 }
 
 func (repo *PostgresAlbumRepository) Update(ctx context.Context, id uuid.UUID, updateFn func(*album.Album) (*album.Album, error)) (*album.Album, error) {
@@ -116,6 +154,8 @@ func (repo *PostgresAlbumRepository) Update(ctx context.Context, id uuid.UUID, u
 			Set("name", alb.Name()).
 			Set("description", alb.Description()).
 			Set("owner_id", alb.GetOwnerID()).
+			Set("visibility", string(alb.Visibility())).
+			Set("share_token", shareTokenValue(alb)).
 			Set("updated_at", alb.UpdatedAt()).
 			Where(squirrel.Eq{"id": alb.ID()}),
 		)
@@ -183,45 +223,59 @@ type AlbumRow struct {
 	Name        string
 	Description string
 	OwnerID     uuid.UUID
+	Visibility  string
+	ShareToken  string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
 
 func (repo *PostgresAlbumRepository) List(ctx context.Context, ownerID uuid.UUID) ([]*album.Album, error) {
-	albums := make([]*album.Album, 0)
-	albs, err := repo.fetchAlbumsByOwner(ctx, ownerID)
+	albums, err := repo.list(ctx, squirrel.Select(albumColumns...).
+		From("album").
+		Where(squirrel.Eq{"owner_id": ownerID}),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("PostgresAlbumRepository.List failed %w", err)
 	}
-	for _, alb := range albs {
-		var plantIDs uuid.UUIDs
-		plantIDs, err = repo.fetchPlantIDs(ctx, alb.ID)
+	return albums, nil
+}
+
+// ListPublic lists public albums, recently updated first.
+func (repo *PostgresAlbumRepository) ListPublic(ctx context.Context) ([]*album.Album, error) {
+	albums, err := repo.list(ctx, squirrel.Select(albumColumns...).
+		From("album").
+		Where(squirrel.Eq{"visibility": string(album.VisibilityPublic)}).
+		OrderBy("updated_at DESC"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("PostgresAlbumRepository.ListPublic failed %w", err)
+	}
+	return albums, nil
+}
+
+func (repo *PostgresAlbumRepository) list(ctx context.Context, query squirrel.SelectBuilder) ([]*album.Album, error) {
+	albums := make([]*album.Album, 0)
+	albs, err := repo.fetchAlbums(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range albs {
+		plantIDs, err := repo.fetchPlantIDs(ctx, row.ID)
 		if err != nil {
-			return nil, fmt.Errorf("PostgresAlbumRepository.List failed %w", err)
+			return nil, err
 		}
-		alb, err := album.CreateAlbum(
-			alb.ID,
-			alb.Name,
-			alb.Description,
-			plantIDs,
-			alb.OwnerID,
-			alb.CreatedAt,
-			alb.UpdatedAt,
-		)
+		alb, err := row.toAlbum(plantIDs)
 		if err != nil {
-			return nil, fmt.Errorf("PostgresAlbumRepository.List failed %w", err)
+			return nil, err
 		}
 		albums = append(albums, alb)
 	}
 	return albums, nil
 }
 
-func (repo *PostgresAlbumRepository) fetchAlbumsByOwner(ctx context.Context, ownerID uuid.UUID) ([]*AlbumRow, error) {
+func (repo *PostgresAlbumRepository) fetchAlbums(ctx context.Context, query squirrel.SelectBuilder) ([]*AlbumRow, error) {
 	var albums []*AlbumRow
-	rows, err := repo.db.Query(ctx, squirrel.Select("id", "name", "description", "owner_id", "created_at", "updated_at").
-		From("album").
-		Where(squirrel.Eq{"owner_id": ownerID}),
-	)
+	rows, err := repo.db.Query(ctx, query)
 	if errors.Is(err, sqdb.ErrNoRows) {
 		return albums, nil
 	} else if err != nil {
@@ -230,8 +284,7 @@ func (repo *PostgresAlbumRepository) fetchAlbumsByOwner(ctx context.Context, own
 	defer rows.Close()
 	for rows.Next() {
 		var tmpAlbum AlbumRow
-		err := rows.Scan(&tmpAlbum.ID, &tmpAlbum.Name, &tmpAlbum.Description, &tmpAlbum.OwnerID, &tmpAlbum.CreatedAt, &tmpAlbum.UpdatedAt)
-		if err != nil {
+		if err := tmpAlbum.scan(rows); err != nil {
 			return nil, err
 		}
 		albums = append(albums, &tmpAlbum)
@@ -0,0 +1,75 @@
+//go:build integration
+
+package albumstorage_test
+
+import (
+	"context"
+
+	"PlantSite/internal/models/album"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *AlbumRepositoryTestSuite) TestShareToken() {
+	ctx := context.Background()
+
+	owner := s.pushTestUser()
+	testAlbum := s.createTestAlbum(uuid.UUIDs{s.pushTestPlant().ID()}, owner.ID())
+	require.NoError(s.T(), testAlbum.UpdateVisibility(album.VisibilityUnlisted))
+	_, err := s.albumRepo.Create(ctx, testAlbum)
+	require.NoError(s.T(), err)
+
+	fetchedAlbum, err := s.albumRepo.GetByShareToken(ctx, testAlbum.ShareToken())
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), testAlbum.ID(), fetchedAlbum.ID())
+	assert.Equal(s.T(), album.VisibilityUnlisted, fetchedAlbum.Visibility())
+	assert.Equal(s.T(), testAlbum.ShareToken(), fetchedAlbum.ShareToken())
+
+	_, err = s.albumRepo.Update(ctx, testAlbum.ID(), func(a *album.Album) (*album.Album, error) {
+		return a, a.UpdateVisibility(album.VisibilityPrivate)
+	})
+	require.NoError(s.T(), err)
+
+	_, err = s.albumRepo.GetByShareToken(ctx, testAlbum.ShareToken())
+	require.ErrorIs(s.T(), err, album.ErrAlbumNotFound)
+
+	fetchedAlbum, err = s.albumRepo.Get(ctx, testAlbum.ID())
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), album.VisibilityPrivate, fetchedAlbum.Visibility())
+	assert.Empty(s.T(), fetchedAlbum.ShareToken())
+}
+
+func (s *AlbumRepositoryTestSuite) TestListPublicAlbums() {
+	ctx := context.Background()
+
+	owner := s.pushTestUser()
+	plantIDs := uuid.UUIDs{s.pushTestPlant().ID()}
+
+	publicAlbum := s.createTestAlbum(plantIDs, owner.ID())
+	require.NoError(s.T(), publicAlbum.UpdateVisibility(album.VisibilityPublic))
+	_, err := s.albumRepo.Create(ctx, publicAlbum)
+	require.NoError(s.T(), err)
+
+	unlistedAlbum := s.createTestAlbum(plantIDs, owner.ID())
+	require.NoError(s.T(), unlistedAlbum.UpdateVisibility(album.VisibilityUnlisted))
+	_, err = s.albumRepo.Create(ctx, unlistedAlbum)
+	require.NoError(s.T(), err)
+
+	privateAlbum := s.createTestAlbum(plantIDs, owner.ID())
+	_, err = s.albumRepo.Create(ctx, privateAlbum)
+	require.NoError(s.T(), err)
+
+	albums, err := s.albumRepo.ListPublic(ctx)
+	require.NoError(s.T(), err)
+
+	albumIDs := make([]uuid.UUID, 0, len(albums))
+	for _, a := range albums {
+		albumIDs = append(albumIDs, a.ID())
+		assert.Equal(s.T(), album.VisibilityPublic, a.Visibility())
+	}
+	assert.Contains(s.T(), albumIDs, publicAlbum.ID())
+	assert.NotContains(s.T(), albumIDs, unlistedAlbum.ID())
+	assert.NotContains(s.T(), albumIDs, privateAlbum.ID())
+}
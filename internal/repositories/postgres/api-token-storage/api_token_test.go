@@ -0,0 +1,148 @@
+//go:build integration
+
+package apitokenstorage_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"PlantSite/internal/infra/sqpgx"
+	"PlantSite/internal/models/auth"
+	apitokenstorage "PlantSite/internal/repositories/postgres/api-token-storage"
+	authstorage "PlantSite/internal/repositories/postgres/auth-storage"
+	"PlantSite/internal/repositories/tests"
+	authservice "PlantSite/internal/services/auth-service"
+	"PlantSite/internal/testutils/pgtest"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type APITokenStorageTestSuite struct {
+	suite.Suite
+	container testcontainers.Container
+	db        *sqpgx.SquirrelPgx
+	storage   *apitokenstorage.PostgresAPITokenStorage
+	userRepo  *authstorage.PostgresAuthRepository
+	prevDir   string
+}
+
+func TestAPITokenStorageSuite(t *testing.T) {
+	suite.Run(t, new(APITokenStorageTestSuite))
+}
+
+func (s *APITokenStorageTestSuite) SetupSuite() {
+	ctx := context.Background()
+
+	// Save current directory
+	prevDir, err := os.Getwd()
+	require.NoError(s.T(), err)
+	s.prevDir = prevDir
+
+	// Change directory to test working directory
+	err = os.Chdir(tests.GetTestWorkingDir())
+	require.NoError(s.T(), err)
+
+	// Create new container
+	container, creds, err := pgtest.NewTestPostgres(ctx)
+	require.NoError(s.T(), err)
+	s.container = container
+
+	// Run migrations
+	err = pgtest.Migrate(ctx, &creds)
+	require.NoError(s.T(), err)
+
+	// Create database connection
+	config := &sqpgx.SqpgxConfig{
+		User:                   creds.User,
+		Password:               creds.Password,
+		DbName:                 creds.Database,
+		Host:                   creds.Host,
+		Port:                   creds.Port,
+		MaxConnections:         10,
+		MaxConnectionsLifetime: time.Minute,
+	}
+
+	db, err := sqpgx.NewSquirrelPgx(ctx, config)
+	require.NoError(s.T(), err)
+	s.db = db
+
+	// Create storages
+	storage, err := apitokenstorage.NewPostgresAPITokenStorage(ctx, db)
+	require.NoError(s.T(), err)
+	s.storage = storage
+
+	s.userRepo, err = authstorage.NewPostgresAuthRepository(ctx, db)
+	require.NoError(s.T(), err)
+}
+
+func (s *APITokenStorageTestSuite) TearDownSuite() {
+	if s.container != nil {
+		s.container.Terminate(context.Background())
+	}
+	err := os.Chdir(s.prevDir)
+	require.NoError(s.T(), err)
+}
+
+func (s *APITokenStorageTestSuite) pushTestToken(memberID uuid.UUID, scope auth.TokenScope) *authservice.APIToken {
+	hash := uuid.New()
+	token := &authservice.APIToken{
+		ID:        uuid.New(),
+		MemberID:  memberID,
+		Name:      "script",
+		Scope:     scope,
+		TokenHash: hash[:],
+		CreatedAt: time.Now(),
+	}
+	require.NoError(s.T(), s.storage.Store(context.Background(), token))
+	return token
+}
+
+func (s *APITokenStorageTestSuite) pushTestMember() uuid.UUID {
+	member, err := auth.NewMember(uuid.NewString()[:8], uuid.NewString()[:8]+"@example.com", []byte("hash"))
+	require.NoError(s.T(), err)
+	_, err = s.userRepo.Create(context.Background(), member)
+	require.NoError(s.T(), err)
+	return member.ID()
+}
+
+func (s *APITokenStorageTestSuite) TestGetByHash() {
+	ctx := context.Background()
+	token := s.pushTestToken(s.pushTestMember(), auth.ScopeAuthor)
+
+	got, err := s.storage.GetByHash(ctx, token.TokenHash)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), token.ID, got.ID)
+	require.Equal(s.T(), token.MemberID, got.MemberID)
+	require.Equal(s.T(), auth.ScopeAuthor, got.Scope)
+
+	hash := uuid.New()
+	_, err = s.storage.GetByHash(ctx, hash[:])
+	require.ErrorIs(s.T(), err, authservice.ErrAPITokenNotFound)
+}
+
+func (s *APITokenStorageTestSuite) TestListAndDelete() {
+	ctx := context.Background()
+	memberID := s.pushTestMember()
+	first := s.pushTestToken(memberID, auth.ScopeReadOnly)
+	second := s.pushTestToken(memberID, auth.ScopeAuthor)
+	other := s.pushTestToken(s.pushTestMember(), auth.ScopeAuthor)
+
+	tokens, err := s.storage.List(ctx, memberID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), tokens, 2)
+	require.Equal(s.T(), first.ID, tokens[0].ID)
+	require.Equal(s.T(), second.ID, tokens[1].ID)
+
+	// Tokens of other members can't be revoked
+	err = s.storage.Delete(ctx, memberID, other.ID)
+	require.ErrorIs(s.T(), err, authservice.ErrAPITokenNotFound)
+
+	require.NoError(s.T(), s.storage.Delete(ctx, memberID, first.ID))
+	_, err = s.storage.GetByHash(ctx, first.TokenHash)
+	require.ErrorIs(s.T(), err, authservice.ErrAPITokenNotFound)
+}
@@ -0,0 +1,106 @@
+package apitokenstorage
+
+import (
+	"PlantSite/internal/infra/sqdb"
+	"PlantSite/internal/models/auth"
+	authservice "PlantSite/internal/services/auth-service"
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+var _ authservice.APITokenStorage = (*PostgresAPITokenStorage)(nil)
+
+type PostgresAPITokenStorage struct {
+	db sqdb.SquirrelDatabase
+}
+
+func NewPostgresAPITokenStorage(_ context.Context, db sqdb.SquirrelDatabase) (*PostgresAPITokenStorage, error) {
+	return &PostgresAPITokenStorage{db: db}, nil
+}
+
+func (storage *PostgresAPITokenStorage) Store(ctx context.Context, token *authservice.APIToken) error {
+	_, err := storage.db.Insert(ctx,
+		squirrel.Insert("api_token").
+			Columns("id", "member_id", "name", "scope", "token_hash", "created_at").
+			Values(token.ID, token.MemberID, token.Name, string(token.Scope), token.TokenHash, token.CreatedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("PostgresAPITokenStorage.Store failed %w", err)
+	}
+	return nil
+}
+
+func selectAPITokens() squirrel.SelectBuilder {
+	return squirrel.Select("id", "member_id", "name", "scope", "token_hash", "created_at").
+		From("api_token")
+}
+
+func scanAPIToken(row sqdb.Row) (*authservice.APIToken, error) {
+	var token authservice.APIToken
+	var scope string
+	err := row.Scan(&token.ID, &token.MemberID, &token.Name, &scope, &token.TokenHash, &token.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	token.Scope = auth.TokenScope(scope)
+	return &token, nil
+}
+
+func (storage *PostgresAPITokenStorage) GetByHash(ctx context.Context, tokenHash []byte) (*authservice.APIToken, error) {
+	row, err := storage.db.QueryRow(ctx,
+		selectAPITokens().Where(squirrel.Eq{"token_hash": tokenHash}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("PostgresAPITokenStorage.GetByHash failed %w", err)
+	}
+
+	token, err := scanAPIToken(row)
+	if err == sqdb.ErrNoRows {
+		return nil, authservice.ErrAPITokenNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("PostgresAPITokenStorage.GetByHash failed %w", err)
+	}
+	return token, nil
+}
+
+func (storage *PostgresAPITokenStorage) List(ctx context.Context, memberID uuid.UUID) ([]*authservice.APIToken, error) {
+	rows, err := storage.db.Query(ctx,
+		selectAPITokens().
+			Where(squirrel.Eq{"member_id": memberID}).
+			OrderBy("created_at"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("PostgresAPITokenStorage.List failed %w", err)
+	}
+	defer rows.Close()
+
+	tokens := make([]*authservice.APIToken, 0)
+	for rows.Next() {
+		token, err := scanAPIToken(rows)
+		if err != nil {
+			return nil, fmt.Errorf("PostgresAPITokenStorage.List failed %w", err)
+		}
+		tokens = append(tokens, token)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("PostgresAPITokenStorage.List failed %w", err)
+	}
+	return tokens, nil
+}
+
+func (storage *PostgresAPITokenStorage) Delete(ctx context.Context, memberID, id uuid.UUID) error {
+	tag, err := storage.db.Delete(ctx,
+		squirrel.Delete("api_token").
+			Where(squirrel.Eq{"id": id, "member_id": memberID}),
+	)
+	if err != nil {
+		return fmt.Errorf("PostgresAPITokenStorage.Delete failed %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return authservice.ErrAPITokenNotFound
+	}
+	return nil
+}
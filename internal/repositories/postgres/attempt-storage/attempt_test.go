@@ -0,0 +1,145 @@
+//go:build integration
+
+package attemptstorage_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"PlantSite/internal/infra/sqpgx"
+	attemptstorage "PlantSite/internal/repositories/postgres/attempt-storage"
+	"PlantSite/internal/repositories/tests"
+	authservice "PlantSite/internal/services/auth-service"
+	"PlantSite/internal/testutils/pgtest"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type LoginAttemptStorageTestSuite struct {
+	suite.Suite
+	container testcontainers.Container
+	db        *sqpgx.SquirrelPgx
+	storage   *attemptstorage.PostgresLoginAttemptStorage
+	prevDir   string
+}
+
+func TestLoginAttemptStorageSuite(t *testing.T) {
+	suite.Run(t, new(LoginAttemptStorageTestSuite))
+}
+
+func (s *LoginAttemptStorageTestSuite) SetupSuite() {
+	ctx := context.Background()
+
+	// Save current directory
+	prevDir, err := os.Getwd()
+	require.NoError(s.T(), err)
+	s.prevDir = prevDir
+
+	// Change directory to test working directory
+	err = os.Chdir(tests.GetTestWorkingDir())
+	require.NoError(s.T(), err)
+
+	// Create new container
+	container, creds, err := pgtest.NewTestPostgres(ctx)
+	require.NoError(s.T(), err)
+	s.container = container
+
+	// Run migrations
+	err = pgtest.Migrate(ctx, &creds)
+	require.NoError(s.T(), err)
+
+	// Create database connection
+	config := &sqpgx.SqpgxConfig{
+		User:                   creds.User,
+		Password:               creds.Password,
+		DbName:                 creds.Database,
+		Host:                   creds.Host,
+		Port:                   creds.Port,
+		MaxConnections:         10,
+		MaxConnectionsLifetime: time.Minute,
+	}
+
+	db, err := sqpgx.NewSquirrelPgx(ctx, config)
+	require.NoError(s.T(), err)
+	s.db = db
+
+	// Create storage
+	storage, err := attemptstorage.NewPostgresLoginAttemptStorage(ctx, db)
+	require.NoError(s.T(), err)
+	s.storage = storage
+}
+
+func (s *LoginAttemptStorageTestSuite) TearDownSuite() {
+	if s.container != nil {
+		s.container.Terminate(context.Background())
+	}
+	err := os.Chdir(s.prevDir)
+	require.NoError(s.T(), err)
+}
+
+func (s *LoginAttemptStorageTestSuite) TestAddFailure() {
+	ctx := context.Background()
+	key := "identifier:" + uuid.NewString()
+	now := time.Now()
+
+	_, err := s.storage.Get(ctx, key)
+	require.ErrorIs(s.T(), err, authservice.ErrNoLoginAttempts)
+
+	for i := 1; i <= 3; i++ {
+		attempts, err := s.storage.AddFailure(ctx, key, now, now.Add(time.Hour))
+		require.NoError(s.T(), err)
+		require.Equal(s.T(), i, attempts.Failures)
+	}
+
+	attempts, err := s.storage.Get(ctx, key)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), key, attempts.Key)
+	require.Equal(s.T(), 3, attempts.Failures)
+	require.WithinDuration(s.T(), now, attempts.LastFailure, time.Millisecond)
+
+	require.NoError(s.T(), s.storage.Delete(ctx, key))
+	_, err = s.storage.Get(ctx, key)
+	require.ErrorIs(s.T(), err, authservice.ErrNoLoginAttempts)
+}
+
+func (s *LoginAttemptStorageTestSuite) TestExpiredFailuresForgotten() {
+	ctx := context.Background()
+	key := "ip:" + uuid.NewString()
+	past := time.Now().Add(-2 * time.Hour)
+
+	_, err := s.storage.AddFailure(ctx, key, past, past.Add(time.Hour))
+	require.NoError(s.T(), err)
+	_, err = s.storage.Get(ctx, key)
+	require.ErrorIs(s.T(), err, authservice.ErrNoLoginAttempts)
+
+	now := time.Now()
+	attempts, err := s.storage.AddFailure(ctx, key, now, now.Add(time.Hour))
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 1, attempts.Failures)
+}
+
+func (s *LoginAttemptStorageTestSuite) TestClearExpired() {
+	ctx := context.Background()
+	expired := "identifier:" + uuid.NewString()
+	active := "identifier:" + uuid.NewString()
+	past := time.Now().Add(-2 * time.Hour)
+	now := time.Now()
+
+	_, err := s.storage.AddFailure(ctx, expired, past, past.Add(time.Hour))
+	require.NoError(s.T(), err)
+	_, err = s.storage.AddFailure(ctx, active, now, now.Add(time.Hour))
+	require.NoError(s.T(), err)
+
+	require.NoError(s.T(), s.storage.ClearExpired(ctx))
+
+	attempts, err := s.storage.AddFailure(ctx, expired, now, now.Add(time.Hour))
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 1, attempts.Failures)
+	_, err = s.storage.Get(ctx, active)
+	require.NoError(s.T(), err)
+}
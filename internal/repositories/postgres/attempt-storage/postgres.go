@@ -0,0 +1,93 @@
+package attemptstorage
+
+import (
+	"PlantSite/internal/infra/sqdb"
+	authservice "PlantSite/internal/services/auth-service"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+)
+
+var _ authservice.LoginAttemptStorage = (*PostgresLoginAttemptStorage)(nil)
+
+// PostgresLoginAttemptStorage shares failed login counters between API replicas.
+type PostgresLoginAttemptStorage struct {
+	db sqdb.SquirrelDatabase
+}
+
+func NewPostgresLoginAttemptStorage(_ context.Context, db sqdb.SquirrelDatabase) (*PostgresLoginAttemptStorage, error) {
+	return &PostgresLoginAttemptStorage{db: db}, nil
+}
+
+func (storage *PostgresLoginAttemptStorage) Get(ctx context.Context, key string) (*authservice.LoginAttempts, error) {
+	row, err := storage.db.QueryRow(ctx,
+		squirrel.Select("attempt_key", "failures", "last_failure_at", "expires_at").
+			From("login_attempt").
+			Where(squirrel.Eq{"attempt_key": key}).
+			Where(squirrel.GtOrEq{"expires_at": time.Now()}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("PostgresLoginAttemptStorage.Get failed %w", err)
+	}
+
+	var attempts authservice.LoginAttempts
+	err = row.Scan(&attempts.Key, &attempts.Failures, &attempts.LastFailure, &attempts.ExpiresAt)
+	if err == sqdb.ErrNoRows {
+		return nil, authservice.ErrNoLoginAttempts
+	} else if err != nil {
+		return nil, fmt.Errorf("PostgresLoginAttemptStorage.Get failed %w", err)
+	}
+	return &attempts, nil
+}
+
+func (storage *PostgresLoginAttemptStorage) AddFailure(ctx context.Context, key string, at, expiresAt time.Time) (*authservice.LoginAttempts, error) {
+	// The upsert counts concurrent failures without losing any of them
+	row, err := storage.db.QueryRow(ctx,
+		squirrel.Select("attempt_key", "failures", "last_failure_at", "expires_at").
+			Prefix("WITH added AS (?)",
+				squirrel.Insert("login_attempt").
+					Columns("attempt_key", "failures", "last_failure_at", "expires_at").
+					Values(key, 1, at, expiresAt).
+					Suffix(`ON CONFLICT (attempt_key) DO UPDATE SET
+						failures = CASE WHEN login_attempt.expires_at < EXCLUDED.last_failure_at THEN 1 ELSE login_attempt.failures + 1 END,
+						last_failure_at = EXCLUDED.last_failure_at,
+						expires_at = EXCLUDED.expires_at
+						RETURNING attempt_key, failures, last_failure_at, expires_at`),
+			).
+			From("added"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("PostgresLoginAttemptStorage.AddFailure failed %w", err)
+	}
+
+	var attempts authservice.LoginAttempts
+	err = row.Scan(&attempts.Key, &attempts.Failures, &attempts.LastFailure, &attempts.ExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("PostgresLoginAttemptStorage.AddFailure failed %w", err)
+	}
+	return &attempts, nil
+}
+
+func (storage *PostgresLoginAttemptStorage) Delete(ctx context.Context, key string) error {
+	_, err := storage.db.Delete(ctx,
+		squirrel.Delete("login_attempt").
+			Where(squirrel.Eq{"attempt_key": key}),
+	)
+	if err != nil {
+		return fmt.Errorf("PostgresLoginAttemptStorage.Delete failed %w", err)
+	}
+	return nil
+}
+
+func (storage *PostgresLoginAttemptStorage) ClearExpired(ctx context.Context) error {
+	_, err := storage.db.Delete(ctx,
+		squirrel.Delete("login_attempt").
+			Where(squirrel.Lt{"expires_at": time.Now()}),
+	)
+	if err != nil {
+		return fmt.Errorf("PostgresLoginAttemptStorage.ClearExpired failed %w", err)
+	}
+	return nil
+}
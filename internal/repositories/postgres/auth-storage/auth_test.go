@@ -262,6 +262,30 @@ func (s *AuthRepositoryTestSuite) TestUpdateMember() {
 	assert.Equal(s.T(), newPassword, fetchedMember.HashedPassword())
 }
 
+func (s *AuthRepositoryTestSuite) TestEmailVerification() {
+	ctx := context.Background()
+	testMember := s.createTestMember()
+	testMember.MarkEmailUnverified()
+
+	_, err := s.repo.Create(ctx, testMember)
+	require.NoError(s.T(), err)
+
+	fetchedUser, err := s.repo.Get(ctx, testMember.ID())
+	require.NoError(s.T(), err)
+	assert.False(s.T(), auth.IsEmailVerified(fetchedUser))
+
+	_, err = s.repo.Update(ctx, testMember.ID(), func(u auth.User) (auth.User, error) {
+		member := u.(*auth.Member)
+		member.MarkEmailVerified()
+		return member, nil
+	})
+	require.NoError(s.T(), err)
+
+	fetchedUser, err = s.repo.Get(ctx, testMember.ID())
+	require.NoError(s.T(), err)
+	assert.True(s.T(), auth.IsEmailVerified(fetchedUser))
+}
+
 func (s *AuthRepositoryTestSuite) TestUpdateNonExistentMember() {
 	ctx := context.Background()
 	nonExistentID := uuid.New()
@@ -374,3 +398,156 @@ func (s *AuthRepositoryTestSuite) TestGetAuthor() {
 	assert.Equal(s.T(), testAuthor.Name(), fetchedAuthor.Name())
 	assert.True(s.T(), fetchedAuthor.HasRights())
 }
+
+func (s *AuthRepositoryTestSuite) TestListMembers() {
+	ctx := context.Background()
+	testMember := s.createTestMember()
+	testAuthor := s.createTestAuthor()
+
+	_, err := s.repo.Create(ctx, testMember)
+	require.NoError(s.T(), err)
+	_, err = s.repo.Create(ctx, &testAuthor.Member)
+	require.NoError(s.T(), err)
+	_, err = s.repo.Update(ctx, testAuthor.ID(), func(u auth.User) (auth.User, error) {
+		return testAuthor, nil
+	})
+	require.NoError(s.T(), err)
+
+	users, err := s.repo.List(ctx, 0, 0)
+	require.NoError(s.T(), err)
+
+	found := make(map[uuid.UUID]auth.User)
+	for _, user := range users {
+		found[user.ID()] = user
+	}
+	require.Contains(s.T(), found, testMember.ID())
+	require.Contains(s.T(), found, testAuthor.ID())
+	assert.IsType(s.T(), &auth.Member{}, found[testMember.ID()])
+	assert.IsType(s.T(), &auth.Author{}, found[testAuthor.ID()])
+	assert.True(s.T(), found[testAuthor.ID()].HasAuthorRights())
+
+	page, err := s.repo.List(ctx, 1, 1)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), page, 1)
+	assert.Equal(s.T(), users[1].ID(), page[0].ID())
+}
+
+func (s *AuthRepositoryTestSuite) TestAuthorRightsHistory() {
+	ctx := context.Background()
+	testAuthor := s.createTestAuthor()
+
+	_, err := s.repo.Create(ctx, &testAuthor.Member)
+	require.NoError(s.T(), err)
+	_, err = s.repo.Update(ctx, testAuthor.ID(), func(u auth.User) (auth.User, error) {
+		return testAuthor, nil
+	})
+	require.NoError(s.T(), err)
+
+	// Changing the name only must not add a history entry
+	_, err = s.repo.Update(ctx, testAuthor.ID(), func(u auth.User) (auth.User, error) {
+		author := u.(*auth.Author)
+		author.UpdateName(author.Name() + "x")
+		return author, nil
+	})
+	require.NoError(s.T(), err)
+
+	_, err = s.repo.Update(ctx, testAuthor.ID(), func(u auth.User) (auth.User, error) {
+		author := u.(*auth.Author)
+		author.RevokeAuthorRights()
+		return author, nil
+	})
+	require.NoError(s.T(), err)
+
+	history, err := s.repo.AuthorRightsHistory(ctx, testAuthor.ID())
+	require.NoError(s.T(), err)
+	require.Len(s.T(), history, 2)
+	assert.True(s.T(), history[0].HasRights)
+	assert.False(s.T(), history[1].HasRights)
+	assert.False(s.T(), history[1].ChangedAt.Before(history[0].ChangedAt))
+}
+
+func (s *AuthRepositoryTestSuite) TestAdminFlag() {
+	ctx := context.Background()
+	testMember := s.createTestMember()
+	_, err := s.repo.Create(ctx, testMember)
+	require.NoError(s.T(), err)
+
+	require.NoError(s.T(), s.repo.SetAdmin(ctx, testMember.ID(), true))
+
+	user, err := s.repo.Get(ctx, testMember.ID())
+	require.NoError(s.T(), err)
+	assert.True(s.T(), auth.IsAdmin(user))
+	assert.Equal(s.T(), testMember.Name(), user.Username())
+
+	admins, err := s.repo.ListAdmins(ctx)
+	require.NoError(s.T(), err)
+	ids := make([]uuid.UUID, 0, len(admins))
+	for _, admin := range admins {
+		ids = append(ids, admin.ID())
+	}
+	assert.Contains(s.T(), ids, testMember.ID())
+
+	// Admins are not listed as members
+	users, err := s.repo.List(ctx, 0, 0)
+	require.NoError(s.T(), err)
+	for _, u := range users {
+		assert.NotEqual(s.T(), testMember.ID(), u.ID())
+	}
+
+	require.NoError(s.T(), s.repo.SetAdmin(ctx, testMember.ID(), false))
+	user, err = s.repo.Get(ctx, testMember.ID())
+	require.NoError(s.T(), err)
+	assert.False(s.T(), auth.IsAdmin(user))
+
+	err = s.repo.SetAdmin(ctx, uuid.New(), true)
+	assert.ErrorIs(s.T(), err, auth.ErrUserNotFound)
+}
+
+func (s *AuthRepositoryTestSuite) TestUpdateAdminPassword() {
+	ctx := context.Background()
+	testMember := s.createTestMember()
+	_, err := s.repo.Create(ctx, testMember)
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), s.repo.SetAdmin(ctx, testMember.ID(), true))
+
+	_, err = s.repo.Update(ctx, testMember.ID(), func(user auth.User) (auth.User, error) {
+		admin := user.(*auth.Admin)
+		return admin, admin.UpdateHashedPassword([]byte("new_hash"))
+	})
+	require.NoError(s.T(), err)
+
+	user, err := s.repo.Get(ctx, testMember.ID())
+	require.NoError(s.T(), err)
+	require.True(s.T(), auth.IsAdmin(user))
+	assert.Equal(s.T(), []byte("new_hash"), user.(*auth.Admin).HashedPassword())
+}
+
+func (s *AuthRepositoryTestSuite) TestRoles() {
+	ctx := context.Background()
+	testMember := s.createTestMember()
+	_, err := s.repo.Create(ctx, testMember)
+	require.NoError(s.T(), err)
+
+	roles, err := s.repo.ListRoles(ctx)
+	require.NoError(s.T(), err)
+	assert.ElementsMatch(s.T(), auth.DefaultRoles(), roles)
+
+	require.NoError(s.T(), s.repo.AssignRole(ctx, testMember.ID(), auth.RoleModerator))
+	// Assigning twice is not an error
+	require.NoError(s.T(), s.repo.AssignRole(ctx, testMember.ID(), auth.RoleModerator))
+
+	user, err := s.repo.Get(ctx, testMember.ID())
+	require.NoError(s.T(), err)
+	assert.True(s.T(), auth.NewPolicy(roles).Can(user, auth.PermPostModerate))
+
+	err = s.repo.AssignRole(ctx, testMember.ID(), "root")
+	assert.ErrorIs(s.T(), err, auth.ErrRoleNotFound)
+
+	require.NoError(s.T(), s.repo.UnassignRole(ctx, testMember.ID(), auth.RoleModerator))
+	user, err = s.repo.Get(ctx, testMember.ID())
+	require.NoError(s.T(), err)
+	assert.False(s.T(), auth.NewPolicy(roles).Can(user, auth.PermPostModerate))
+
+	err = s.repo.UnassignRole(ctx, testMember.ID(), auth.RoleModerator)
+	assert.ErrorIs(s.T(), err, auth.ErrRoleNotFound)
+}
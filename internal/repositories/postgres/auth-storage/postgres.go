@@ -12,6 +12,11 @@ import (
 	"github.com/google/uuid"
 )
 
+var (
+	_ auth.AuthRepository   = (*PostgresAuthRepository)(nil)
+	_ auth.MemberRepository = (*PostgresAuthRepository)(nil)
+)
+
 type PostgresAuthRepository struct {
 	db sqdb.SquirrelDatabase
 }
@@ -21,11 +26,30 @@ func NewPostgresAuthRepository(_ context.Context, db sqdb.SquirrelDatabase) (*Po
 }
 
 type Member struct {
-	ID           uuid.UUID
-	Name         string
-	Email        string
-	PasswordHash []byte
-	CreatedAt    time.Time
+	ID            uuid.UUID
+	Name          string
+	Email         string
+	PasswordHash  []byte
+	CreatedAt     time.Time
+	EmailVerified bool
+	IsAdmin       bool
+}
+
+func (mem *Member) toDomain() (*auth.Member, error) {
+	domainMem, err := auth.CreateMember(
+		mem.ID,
+		mem.Name,
+		mem.Email,
+		mem.PasswordHash,
+		mem.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !mem.EmailVerified {
+		domainMem.MarkEmailUnverified()
+	}
+	return domainMem, nil
 }
 
 type Author struct {
@@ -35,10 +59,10 @@ type Author struct {
 	RevokeTime time.Time
 }
 
-func (repo *PostgresAuthRepository) getMember(ctx context.Context, whereStatement interface{}, args ...interface{}) (*auth.Member, error) {
+func (repo *PostgresAuthRepository) getMember(ctx context.Context, whereStatement interface{}, args ...interface{}) (*Member, error) {
 	var mem *Member = &Member{}
 	row, err := repo.db.QueryRow(ctx,
-		squirrel.Select("id", "username", "email", "password_hash", "created_at").
+		squirrel.Select("id", "username", "email", "password_hash", "created_at", "email_verified", "is_admin").
 			From("app_user").
 			Where(whereStatement, args...),
 	)
@@ -47,7 +71,7 @@ func (repo *PostgresAuthRepository) getMember(ctx context.Context, whereStatemen
 		return nil, fmt.Errorf("PostgresAuthRepository.getMember failed %w", err)
 	}
 
-	err = row.Scan(&mem.ID, &mem.Name, &mem.Email, &mem.PasswordHash, &mem.CreatedAt)
+	err = row.Scan(&mem.ID, &mem.Name, &mem.Email, &mem.PasswordHash, &mem.CreatedAt, &mem.EmailVerified, &mem.IsAdmin)
 
 	if err == sqdb.ErrNoRows {
 		return nil, auth.ErrUserNotFound
@@ -55,18 +79,7 @@ func (repo *PostgresAuthRepository) getMember(ctx context.Context, whereStatemen
 		return nil, fmt.Errorf("PostgresAuthRepository.getMember failed %w", err)
 	}
 
-	domainMem, err := auth.CreateMember(
-		mem.ID,
-		mem.Name,
-		mem.Email,
-		mem.PasswordHash,
-		mem.CreatedAt,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("PostgresAuthRepository.getMember failed %w", err)
-	}
-
-	return domainMem, err
+	return mem, nil
 }
 
 func (repo *PostgresAuthRepository) getAuthor(ctx context.Context, mem *auth.Member) (*auth.Author, error) {
@@ -103,13 +116,31 @@ func (repo *PostgresAuthRepository) getAuthor(ctx context.Context, mem *auth.Mem
 }
 
 func (repo *PostgresAuthRepository) getUser(ctx context.Context, whereStatement interface{}, args ...interface{}) (auth.User, error) {
-	domainMem, err := repo.getMember(ctx, whereStatement, args...)
+	mem, err := repo.getMember(ctx, whereStatement, args...)
 	if errors.Is(err, auth.ErrUserNotFound) {
 		return nil, auth.ErrUserNotFound
 	} else if err != nil {
 		return nil, err
 	}
 
+	if mem.IsAdmin {
+		admin, err := auth.CreateAdmin(mem.ID, mem.Name, mem.PasswordHash)
+		if err != nil {
+			return nil, fmt.Errorf("PostgresAuthRepository.getUser failed %w", err)
+		}
+		return admin, nil
+	}
+
+	domainMem, err := mem.toDomain()
+	if err != nil {
+		return nil, fmt.Errorf("PostgresAuthRepository.getUser failed %w", err)
+	}
+	roles, err := repo.userRoles(ctx, domainMem.ID())
+	if err != nil {
+		return nil, err
+	}
+	domainMem.SetRoles(roles)
+
 	domainAuth, err := repo.getAuthor(ctx, domainMem)
 	if errors.Is(err, auth.ErrUserNotFound) {
 		return domainMem, nil
@@ -128,8 +159,8 @@ func (repo *PostgresAuthRepository) Get(ctx context.Context, id uuid.UUID) (auth
 func (repo *PostgresAuthRepository) Create(ctx context.Context, mem *auth.Member) (auth.User, error) {
 	_, err := repo.db.Insert(ctx,
 		squirrel.Insert("app_user").
-			Columns("id", "username", "email", "password_hash", "created_at").
-			Values(mem.ID(), mem.Name(), mem.Email(), mem.HashedPassword(), mem.CreatedAt()),
+			Columns("id", "username", "email", "password_hash", "created_at", "email_verified").
+			Values(mem.ID(), mem.Name(), mem.Email(), mem.HashedPassword(), mem.CreatedAt(), mem.EmailVerified()),
 	)
 	if err != nil {
 		return nil, err
@@ -144,11 +175,22 @@ func (repo *PostgresAuthRepository) updateMember(ctx context.Context, updMember
 			Set("username", updMember.Name()).
 			Set("email", updMember.Email()).
 			Set("password_hash", updMember.HashedPassword()).
+			Set("email_verified", updMember.EmailVerified()).
 			Where(squirrel.Eq{"id": updMember.ID()}),
 	)
 	return err
 }
 
+// updateAdmin saves the password only, the rest of an admin is managed with SetAdmin and the admin command.
+func (repo *PostgresAuthRepository) updateAdmin(ctx context.Context, updAdmin *auth.Admin) error {
+	_, err := repo.db.Update(ctx,
+		squirrel.Update("app_user").
+			Set("password_hash", updAdmin.HashedPassword()).
+			Where(squirrel.Eq{"id": updAdmin.ID()}),
+	)
+	return err
+}
+
 func (repo *PostgresAuthRepository) updateAuthor(ctx context.Context, updAuth *auth.Author) error {
 	_, err := repo.db.Insert(ctx, squirrel.Insert("author").
 		Columns("id", "has_rights", "grant_at", "revoke_at").
@@ -187,6 +229,12 @@ func (repo *PostgresAuthRepository) Update(ctx context.Context, id uuid.UUID, up
 			return nil, err
 		}
 		return updUsr, nil
+	case *auth.Admin:
+		err = repo.updateAdmin(ctx, fact)
+		if err != nil {
+			return nil, err
+		}
+		return updUsr, nil
 	default:
 		return nil, fmt.Errorf("unsupported user type: %v", updUsr)
 	}
@@ -199,3 +247,120 @@ func (repo *PostgresAuthRepository) GetByName(ctx context.Context, name string)
 func (repo *PostgresAuthRepository) GetByEmail(ctx context.Context, email string) (auth.User, error) {
 	return repo.getUser(ctx, squirrel.Eq{`"email"`: email})
 }
+
+// ListAdmins returns the users with the admin flag.
+func (repo *PostgresAuthRepository) ListAdmins(ctx context.Context) ([]*auth.Admin, error) {
+	rows, err := repo.db.Query(ctx,
+		squirrel.Select("id", "username", "password_hash").
+			From("app_user").
+			Where(squirrel.Eq{"is_admin": true}).
+			OrderBy("created_at", "id"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("PostgresAuthRepository.ListAdmins failed %w", err)
+	}
+	defer rows.Close()
+
+	admins := make([]*auth.Admin, 0)
+	for rows.Next() {
+		var mem Member
+		if err := rows.Scan(&mem.ID, &mem.Name, &mem.PasswordHash); err != nil {
+			return nil, fmt.Errorf("PostgresAuthRepository.ListAdmins failed %w", err)
+		}
+		admin, err := auth.CreateAdmin(mem.ID, mem.Name, mem.PasswordHash)
+		if err != nil {
+			return nil, fmt.Errorf("PostgresAuthRepository.ListAdmins failed %w", err)
+		}
+		admins = append(admins, admin)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("PostgresAuthRepository.ListAdmins failed %w", err)
+	}
+	return admins, nil
+}
+
+// SetAdmin sets or clears the admin flag of the user.
+func (repo *PostgresAuthRepository) SetAdmin(ctx context.Context, id uuid.UUID, isAdmin bool) error {
+	_, err := repo.db.Update(ctx,
+		squirrel.Update("app_user").
+			Set("is_admin", isAdmin).
+			Where(squirrel.Eq{"id": id}),
+	)
+	if errors.Is(err, sqdb.ErrNoRows) {
+		return auth.ErrUserNotFound
+	} else if err != nil {
+		return fmt.Errorf("PostgresAuthRepository.SetAdmin failed %w", err)
+	}
+	return nil
+}
+
+func (repo *PostgresAuthRepository) List(ctx context.Context, offset, limit int) ([]auth.User, error) {
+	query := squirrel.Select("u.id", "u.username", "u.email", "u.password_hash", "u.created_at", "u.email_verified", "a.has_rights", "a.grant_at", "a.revoke_at").
+		From("app_user u").
+		LeftJoin("author a ON a.id = u.id").
+		Where(squirrel.Eq{"u.is_admin": false}).
+		OrderBy("u.created_at", "u.id").
+		Offset(uint64(offset))
+	if limit > 0 {
+		query = query.Limit(uint64(limit))
+	}
+	rows, err := repo.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("PostgresAuthRepository.List failed %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]auth.User, 0)
+	for rows.Next() {
+		var mem Member
+		var rights *bool
+		var giveTime, revokeTime *time.Time
+		err := rows.Scan(&mem.ID, &mem.Name, &mem.Email, &mem.PasswordHash, &mem.CreatedAt, &mem.EmailVerified, &rights, &giveTime, &revokeTime)
+		if err != nil {
+			return nil, fmt.Errorf("PostgresAuthRepository.List failed %w", err)
+		}
+		domainMem, err := mem.toDomain()
+		if err != nil {
+			return nil, fmt.Errorf("PostgresAuthRepository.List failed %w", err)
+		}
+		if rights == nil {
+			users = append(users, domainMem)
+			continue
+		}
+		domainAuth, err := auth.CreateAuthor(*domainMem, *giveTime, *rights, *revokeTime)
+		if err != nil {
+			return nil, fmt.Errorf("PostgresAuthRepository.List failed %w", err)
+		}
+		users = append(users, domainAuth)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("PostgresAuthRepository.List failed %w", err)
+	}
+	return users, nil
+}
+
+func (repo *PostgresAuthRepository) AuthorRightsHistory(ctx context.Context, id uuid.UUID) ([]auth.AuthorRightsChange, error) {
+	rows, err := repo.db.Query(ctx,
+		squirrel.Select("has_rights", "changed_at").
+			From("author_rights_history").
+			Where(squirrel.Eq{"author_id": id}).
+			OrderBy("changed_at", "id"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("PostgresAuthRepository.AuthorRightsHistory failed %w", err)
+	}
+	defer rows.Close()
+
+	history := make([]auth.AuthorRightsChange, 0)
+	for rows.Next() {
+		var change auth.AuthorRightsChange
+		if err := rows.Scan(&change.HasRights, &change.ChangedAt); err != nil {
+			return nil, fmt.Errorf("PostgresAuthRepository.AuthorRightsHistory failed %w", err)
+		}
+		history = append(history, change)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("PostgresAuthRepository.AuthorRightsHistory failed %w", err)
+	}
+	return history, nil
+}
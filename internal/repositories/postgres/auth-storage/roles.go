@@ -0,0 +1,123 @@
+package authstorage
+
+import (
+	"PlantSite/internal/infra/sqdb"
+	"PlantSite/internal/models/auth"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+var _ auth.RoleRepository = (*PostgresAuthRepository)(nil)
+
+func (repo *PostgresAuthRepository) ListRoles(ctx context.Context) ([]auth.Role, error) {
+	rows, err := repo.db.Query(ctx,
+		squirrel.Select("r.name", "p.permission").
+			From("role r").
+			LeftJoin("role_permission p ON p.role_name = r.name").
+			OrderBy("r.name", "p.permission"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("PostgresAuthRepository.ListRoles failed %w", err)
+	}
+	defer rows.Close()
+
+	roles := make([]auth.Role, 0)
+	for rows.Next() {
+		var name string
+		var permission *string
+		if err := rows.Scan(&name, &permission); err != nil {
+			return nil, fmt.Errorf("PostgresAuthRepository.ListRoles failed %w", err)
+		}
+		if len(roles) == 0 || roles[len(roles)-1].Name != name {
+			roles = append(roles, auth.Role{Name: name, Permissions: make([]auth.Permission, 0)})
+		}
+		if permission == nil {
+			continue
+		}
+		perm, err := auth.ParsePermission(*permission)
+		if err != nil {
+			return nil, fmt.Errorf("PostgresAuthRepository.ListRoles failed %w", err)
+		}
+		roles[len(roles)-1].Permissions = append(roles[len(roles)-1].Permissions, perm)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("PostgresAuthRepository.ListRoles failed %w", err)
+	}
+	return roles, nil
+}
+
+func (repo *PostgresAuthRepository) roleExists(ctx context.Context, role string) error {
+	row, err := repo.db.QueryRow(ctx,
+		squirrel.Select("name").
+			From("role").
+			Where(squirrel.Eq{"name": role}),
+	)
+	if err != nil {
+		return err
+	}
+	err = row.Scan(&role)
+	if errors.Is(err, sqdb.ErrNoRows) {
+		return auth.ErrRoleNotFound
+	}
+	return err
+}
+
+// AssignRole gives the role to the user, assigning a role twice is not an error.
+func (repo *PostgresAuthRepository) AssignRole(ctx context.Context, userID uuid.UUID, role string) error {
+	if err := repo.roleExists(ctx, role); err != nil {
+		return err
+	}
+	_, err := repo.db.Insert(ctx,
+		squirrel.Insert("user_role").
+			Columns("user_id", "role_name").
+			Values(userID, role).
+			Suffix("ON CONFLICT DO NOTHING"),
+	)
+	if err != nil && !errors.Is(err, sqdb.ErrNoRows) {
+		return fmt.Errorf("PostgresAuthRepository.AssignRole failed %w", err)
+	}
+	return nil
+}
+
+func (repo *PostgresAuthRepository) UnassignRole(ctx context.Context, userID uuid.UUID, role string) error {
+	_, err := repo.db.Delete(ctx,
+		squirrel.Delete("user_role").
+			Where(squirrel.Eq{"user_id": userID, "role_name": role}),
+	)
+	if errors.Is(err, sqdb.ErrNoRows) {
+		return auth.ErrRoleNotFound
+	} else if err != nil {
+		return fmt.Errorf("PostgresAuthRepository.UnassignRole failed %w", err)
+	}
+	return nil
+}
+
+func (repo *PostgresAuthRepository) userRoles(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	rows, err := repo.db.Query(ctx,
+		squirrel.Select("role_name").
+			From("user_role").
+			Where(squirrel.Eq{"user_id": userID}).
+			OrderBy("role_name"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("PostgresAuthRepository.userRoles failed %w", err)
+	}
+	defer rows.Close()
+
+	roles := make([]string, 0)
+	for rows.Next() {
+		var role string
+		if err := rows.Scan(&role); err != nil {
+			return nil, fmt.Errorf("PostgresAuthRepository.userRoles failed %w", err)
+		}
+		roles = append(roles, role)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("PostgresAuthRepository.userRoles failed %w", err)
+	}
+	return roles, nil
+}
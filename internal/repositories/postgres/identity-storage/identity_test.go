@@ -0,0 +1,127 @@
+//go:build integration
+
+package identitystorage_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"PlantSite/internal/infra/sqpgx"
+	"PlantSite/internal/models/auth"
+	authstorage "PlantSite/internal/repositories/postgres/auth-storage"
+	identitystorage "PlantSite/internal/repositories/postgres/identity-storage"
+	"PlantSite/internal/repositories/tests"
+	authservice "PlantSite/internal/services/auth-service"
+	"PlantSite/internal/testutils/pgtest"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type ExternalIdentityStorageTestSuite struct {
+	suite.Suite
+	container testcontainers.Container
+	db        *sqpgx.SquirrelPgx
+	storage   *identitystorage.PostgresExternalIdentityStorage
+	userRepo  *authstorage.PostgresAuthRepository
+	prevDir   string
+}
+
+func TestExternalIdentityStorageSuite(t *testing.T) {
+	suite.Run(t, new(ExternalIdentityStorageTestSuite))
+}
+
+func (s *ExternalIdentityStorageTestSuite) SetupSuite() {
+	ctx := context.Background()
+
+	// Save current directory
+	prevDir, err := os.Getwd()
+	require.NoError(s.T(), err)
+	s.prevDir = prevDir
+
+	// Change directory to test working directory
+	err = os.Chdir(tests.GetTestWorkingDir())
+	require.NoError(s.T(), err)
+
+	// Create new container
+	container, creds, err := pgtest.NewTestPostgres(ctx)
+	require.NoError(s.T(), err)
+	s.container = container
+
+	// Run migrations
+	err = pgtest.Migrate(ctx, &creds)
+	require.NoError(s.T(), err)
+
+	// Create database connection
+	config := &sqpgx.SqpgxConfig{
+		User:                   creds.User,
+		Password:               creds.Password,
+		DbName:                 creds.Database,
+		Host:                   creds.Host,
+		Port:                   creds.Port,
+		MaxConnections:         10,
+		MaxConnectionsLifetime: time.Minute,
+	}
+
+	db, err := sqpgx.NewSquirrelPgx(ctx, config)
+	require.NoError(s.T(), err)
+	s.db = db
+
+	// Create storages
+	storage, err := identitystorage.NewPostgresExternalIdentityStorage(ctx, db)
+	require.NoError(s.T(), err)
+	s.storage = storage
+
+	s.userRepo, err = authstorage.NewPostgresAuthRepository(ctx, db)
+	require.NoError(s.T(), err)
+}
+
+func (s *ExternalIdentityStorageTestSuite) TearDownSuite() {
+	if s.container != nil {
+		s.container.Terminate(context.Background())
+	}
+	err := os.Chdir(s.prevDir)
+	require.NoError(s.T(), err)
+}
+
+func (s *ExternalIdentityStorageTestSuite) pushTestMember() uuid.UUID {
+	member, err := auth.NewMember(uuid.NewString()[:8], uuid.NewString()[:8]+"@example.com", []byte("hash"))
+	require.NoError(s.T(), err)
+	_, err = s.userRepo.Create(context.Background(), member)
+	require.NoError(s.T(), err)
+	return member.ID()
+}
+
+func (s *ExternalIdentityStorageTestSuite) TestStoreAndGet() {
+	ctx := context.Background()
+	identity := &authservice.ExternalIdentity{
+		Issuer:    "https://id.example.com",
+		Subject:   uuid.NewString(),
+		MemberID:  s.pushTestMember(),
+		CreatedAt: time.Now().UTC().Truncate(time.Millisecond),
+	}
+	require.NoError(s.T(), s.storage.Store(ctx, identity))
+
+	got, err := s.storage.Get(ctx, identity.Issuer, identity.Subject)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), identity.MemberID, got.MemberID)
+	assert.WithinDuration(s.T(), identity.CreatedAt, got.CreatedAt, time.Millisecond)
+
+	// Subjects are unique only within the issuer
+	_, err = s.storage.Get(ctx, "https://other.example.com", identity.Subject)
+	assert.ErrorIs(s.T(), err, authservice.ErrExternalIdentityNotFound)
+
+	// An account links to a single member
+	err = s.storage.Store(ctx, &authservice.ExternalIdentity{
+		Issuer:    identity.Issuer,
+		Subject:   identity.Subject,
+		MemberID:  s.pushTestMember(),
+		CreatedAt: time.Now(),
+	})
+	assert.Error(s.T(), err)
+}
@@ -0,0 +1,52 @@
+package identitystorage
+
+import (
+	"PlantSite/internal/infra/sqdb"
+	authservice "PlantSite/internal/services/auth-service"
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+)
+
+var _ authservice.ExternalIdentityStorage = (*PostgresExternalIdentityStorage)(nil)
+
+type PostgresExternalIdentityStorage struct {
+	db sqdb.SquirrelDatabase
+}
+
+func NewPostgresExternalIdentityStorage(_ context.Context, db sqdb.SquirrelDatabase) (*PostgresExternalIdentityStorage, error) {
+	return &PostgresExternalIdentityStorage{db: db}, nil
+}
+
+func (storage *PostgresExternalIdentityStorage) Store(ctx context.Context, identity *authservice.ExternalIdentity) error {
+	_, err := storage.db.Insert(ctx,
+		squirrel.Insert("external_identity").
+			Columns("issuer", "subject", "member_id", "created_at").
+			Values(identity.Issuer, identity.Subject, identity.MemberID, identity.CreatedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("PostgresExternalIdentityStorage.Store failed %w", err)
+	}
+	return nil
+}
+
+func (storage *PostgresExternalIdentityStorage) Get(ctx context.Context, issuer, subject string) (*authservice.ExternalIdentity, error) {
+	row, err := storage.db.QueryRow(ctx,
+		squirrel.Select("issuer", "subject", "member_id", "created_at").
+			From("external_identity").
+			Where(squirrel.Eq{"issuer": issuer, "subject": subject}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("PostgresExternalIdentityStorage.Get failed %w", err)
+	}
+
+	var identity authservice.ExternalIdentity
+	err = row.Scan(&identity.Issuer, &identity.Subject, &identity.MemberID, &identity.CreatedAt)
+	if err == sqdb.ErrNoRows {
+		return nil, authservice.ErrExternalIdentityNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("PostgresExternalIdentityStorage.Get failed %w", err)
+	}
+	return &identity, nil
+}
@@ -68,7 +68,8 @@ func (g *PostgresPlantGet) Get(ctx context.Context, plantID uuid.UUID) (*plant.P
 	rows, err := g.db.Query(ctx,
 		squirrel.Select("id", "file_id", "description").
 			From("plant_photo").
-			Where(squirrel.Eq{"plant_id": plantID}),
+			Where(squirrel.Eq{"plant_id": plantID}).
+			OrderBy("place_number"),
 	)
 	if err != nil {
 		return nil, err
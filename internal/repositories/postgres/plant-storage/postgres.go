@@ -70,10 +70,12 @@ func (repo *PostgresPlantRepository) Create(ctx context.Context, plnt *plant.Pla
 		}
 		if plnt.GetPhotos().Len() > 0 {
 			query := squirrel.Insert("plant_photo").
-				Columns("id", "plant_id", "file_id", "description")
+				Columns("id", "plant_id", "file_id", "description", "place_number")
 
+			placeNumber := 0
 			err = plnt.GetPhotos().Iterate(func(e plant.PlantPhoto) error {
-				query = query.Values(e.ID(), plnt.ID(), e.FileID(), e.Description())
+				placeNumber++
+				query = query.Values(e.ID(), plnt.ID(), e.FileID(), e.Description(), placeNumber)
 				return nil
 			})
 			if err != nil {
@@ -133,9 +135,11 @@ func (repo *PostgresPlantRepository) Update(ctx context.Context, plantID uuid.UU
 		}
 		if plnt.GetPhotos().Len() > 0 {
 			query := squirrel.Insert("plant_photo").
-				Columns("id", "plant_id", "file_id", "description")
+				Columns("id", "plant_id", "file_id", "description", "place_number")
+			placeNumber := 0
 			err = plnt.GetPhotos().Iterate(func(e plant.PlantPhoto) error {
-				query = query.Values(e.ID(), plnt.ID(), e.FileID(), e.Description())
+				placeNumber++
+				query = query.Values(e.ID(), plnt.ID(), e.FileID(), e.Description(), placeNumber)
 				return nil
 			})
 			if err != nil {
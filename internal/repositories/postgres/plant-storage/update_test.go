@@ -76,3 +76,42 @@ func (s *PlantRepositoryTestSuite) TestUpdatePlantWithInvalidPhoto() {
 	})
 	require.Error(s.T(), err)
 }
+
+func (s *PlantRepositoryTestSuite) TestUpdatePlantPhotoOrder() {
+	ctx := context.Background()
+	testPlant := s.createTestPlant(ctx)
+
+	_, err := s.repo.Create(ctx, testPlant)
+	require.NoError(s.T(), err)
+
+	var photoIDs []uuid.UUID
+	_, err = s.repo.Update(ctx, testPlant.ID(), func(p *plant.Plant) (*plant.Plant, error) {
+		p.GetPhotos().Iterate(func(photo plant.PlantPhoto) error {
+			photoIDs = append(photoIDs, photo.ID())
+			return nil
+		})
+		for range 2 {
+			photo, err := plant.NewPlantPhoto(s.pushTestPhoto(ctx), "photo")
+			require.NoError(s.T(), err)
+			require.NoError(s.T(), p.AddPhoto(photo))
+			photoIDs = append(photoIDs, photo.ID())
+		}
+		return p, nil
+	})
+	require.NoError(s.T(), err)
+
+	reordered := []uuid.UUID{photoIDs[2], photoIDs[0], photoIDs[1]}
+	_, err = s.repo.Update(ctx, testPlant.ID(), func(p *plant.Plant) (*plant.Plant, error) {
+		return p, p.ReorderPhotos(reordered)
+	})
+	require.NoError(s.T(), err)
+
+	stored, err := s.repo.Get(ctx, testPlant.ID())
+	require.NoError(s.T(), err)
+	var storedIDs []uuid.UUID
+	stored.GetPhotos().Iterate(func(photo plant.PlantPhoto) error {
+		storedIDs = append(storedIDs, photo.ID())
+		return nil
+	})
+	assert.Equal(s.T(), reordered, storedIDs)
+}
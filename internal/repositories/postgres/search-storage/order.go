@@ -0,0 +1,130 @@
+package searchstorage
+
+import (
+	pgconsts "PlantSite/internal/infra/pg-consts"
+	"PlantSite/internal/models/search"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+)
+
+var plantSortColumns = map[search.SortKey]string{
+	search.SortByName:      "name",
+	search.SortByCreatedAt: "created_at",
+	search.SortByUpdatedAt: "updated_at",
+	search.SortByHeightM:   fmt.Sprintf("(specification->>'%s')::float", pgconsts.JsonBHeightMKey),
+}
+
+var postSortColumns = map[search.SortKey]string{
+	search.SortByTitle:     "title",
+	search.SortByCreatedAt: "created_at",
+	search.SortByUpdatedAt: "updated_at",
+}
+
+var (
+	defaultPlantSort = search.Sort{Key: search.SortByName}
+	defaultPostSort  = search.Sort{Key: search.SortByCreatedAt, Desc: true}
+)
+
+func orderBy(columns map[search.SortKey]string, srt search.Sort) (string, error) {
+	column, ok := columns[srt.Key]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", search.ErrInvalidSortKey, srt.Key)
+	}
+	if srt.Desc {
+		return column + " DESC", nil
+	}
+	return column + " ASC", nil
+}
+
+// rankOrder orders by full-text relevance, the most relevant results go first.
+func rankOrder(query string) squirrel.Sqlizer {
+	return squirrel.Expr(
+		fmt.Sprintf("ts_rank(%s, %s) DESC", pgconsts.SearchVectorColumn, pgconsts.TsQueryExpr),
+		query, query,
+	)
+}
+
+// similarityOrder orders by trigram similarity of the plant names, the closest names go first.
+func similarityOrder(name string) squirrel.Sqlizer {
+	return squirrel.Expr(pgconsts.TrigramRankExpr+" DESC", name, name, name, name)
+}
+
+func plantOrder(srch *search.PlantSearch) (squirrel.Sqlizer, error) {
+	srt := srch.Sort()
+	if srt.Key == search.SortByRelevance {
+		query, ok := plantTextQuery(srch)
+		if ok {
+			return rankOrder(query), nil
+		}
+		name, ok := plantSimilarName(srch)
+		if ok {
+			return similarityOrder(name), nil
+		}
+		srt = defaultPlantSort
+	}
+	order, err := orderBy(plantSortColumns, srt)
+	if err != nil {
+		return nil, err
+	}
+	return squirrel.Expr(order), nil
+}
+
+func postOrder(srch *search.PostSearch) (squirrel.Sqlizer, error) {
+	srt := srch.Sort()
+	if srt.Key == search.SortByRelevance {
+		query, ok := postTextQuery(srch)
+		if ok {
+			return rankOrder(query), nil
+		}
+		srt = defaultPostSort
+	}
+	order, err := orderBy(postSortColumns, srt)
+	if err != nil {
+		return nil, err
+	}
+	return squirrel.Expr(order), nil
+}
+
+func plantTextQuery(srch *search.PlantSearch) (string, bool) {
+	var query string
+	srch.Iterate(func(pf search.PlantFilter) error {
+		if tf, ok := pf.(*search.PlantTextFilter); ok && query == "" {
+			query = tf.Query
+		}
+		return nil
+	})
+	return query, query != ""
+}
+
+func plantSimilarName(srch *search.PlantSearch) (string, bool) {
+	var name string
+	srch.Iterate(func(pf search.PlantFilter) error {
+		if sf, ok := pf.(*search.PlantSimilarNameFilter); ok && name == "" {
+			name = sf.Name
+		}
+		return nil
+	})
+	return name, name != ""
+}
+
+func postTextQuery(srch *search.PostSearch) (string, bool) {
+	var query string
+	srch.Iterate(func(pf search.PostFilter) error {
+		if tf, ok := pf.(*search.PostTextFilter); ok && query == "" {
+			query = tf.Query
+		}
+		return nil
+	})
+	return query, query != ""
+}
+
+// paginate orders the query with id as a tie-breaker,
+// so that consecutive pages never overlap.
+func paginate(sqb squirrel.SelectBuilder, order squirrel.Sqlizer, page search.Page) squirrel.SelectBuilder {
+	sqb = sqb.OrderByClause(order).OrderBy("id ASC")
+	if !page.Unbounded() {
+		sqb = sqb.Limit(uint64(page.Limit)).Offset(uint64(page.Offset))
+	}
+	return sqb
+}
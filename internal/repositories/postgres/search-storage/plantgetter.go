@@ -43,3 +43,13 @@ func (g *SearchPlantGetter) GetPlantByName(name string) (*plant.Plant, error) {
 	}
 	return plants[0], nil
 }
+
+func (g *SearchPlantGetter) SuggestPlants(name string, limit int) ([]*plant.Plant, error) {
+	srch := search.NewPlantSearch()
+	srch.AddFilter(search.NewPlantSimilarNameFilter(name))
+	if err := srch.SetSort(search.Sort{Key: search.SortByRelevance}); err != nil {
+		return nil, err
+	}
+	srch.SetPage(search.Page{Limit: limit})
+	return g.repo.SearchPlants(context.Background(), srch)
+}
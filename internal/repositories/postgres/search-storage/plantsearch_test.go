@@ -5,6 +5,7 @@ package searchstorage_test
 import (
 	"PlantSite/internal/models/plant"
 	"PlantSite/internal/models/search"
+	searchstorage "PlantSite/internal/repositories/postgres/search-storage"
 	"context"
 
 	"github.com/stretchr/testify/assert"
@@ -171,3 +172,64 @@ func (s *SearchRepositoryTestSuite) TestSearchPlantsByFloweringPeriod() {
 	assert.Len(s.T(), springPlants, 1)
 	assert.Equal(s.T(), "Spring Bloomer", springPlants[0].GetName())
 }
+
+func (s *SearchRepositoryTestSuite) TestSearchPlantsPagination() {
+	ctx := context.Background()
+
+	for _, name := range []string{"Cedar", "Abies", "Birch", "Douglas Fir", "Elm"} {
+		pl := s.createConiferousPlant(ctx, name, 1.5, 0.5, plant.MediumMoisture, 10, plant.Light, plant.MediumSoil, plant.WinterHardiness(10))
+		_, err := s.plantRepo.Create(ctx, pl)
+		require.NoError(s.T(), err)
+	}
+
+	srch := search.NewPlantSearch()
+	srch.SetPage(search.Page{Limit: 2, Offset: 0})
+
+	total, err := s.searchRepo.CountPlants(ctx, srch)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 5, total)
+
+	firstPage, err := s.searchRepo.SearchPlants(ctx, srch)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), firstPage, 2)
+	assert.Equal(s.T(), "Abies", firstPage[0].GetName())
+	assert.Equal(s.T(), "Birch", firstPage[1].GetName())
+
+	srch.SetPage(search.Page{Limit: 2, Offset: 4})
+	lastPage, err := s.searchRepo.SearchPlants(ctx, srch)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), lastPage, 1)
+	assert.Equal(s.T(), "Elm", lastPage[0].GetName())
+
+	require.NoError(s.T(), srch.SetSort(search.Sort{Key: search.SortByName, Desc: true}))
+	srch.SetPage(search.Page{Limit: 1, Offset: 0})
+	descPage, err := s.searchRepo.SearchPlants(ctx, srch)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), descPage, 1)
+	assert.Equal(s.T(), "Elm", descPage[0].GetName())
+}
+
+func (s *SearchRepositoryTestSuite) TestSuggestPlantsBySimilarName() {
+	ctx := context.Background()
+
+	for _, name := range []string{"Thuja occidentalis", "Picea abies", "Pinus sylvestris"} {
+		pl := s.createConiferousPlant(ctx, name, 1.5, 0.5, plant.MediumMoisture, 10, plant.Light, plant.MediumSoil, plant.WinterHardiness(10))
+		_, err := s.plantRepo.Create(ctx, pl)
+		require.NoError(s.T(), err)
+	}
+
+	getter := searchstorage.NewSearchPlantGetter(s.searchRepo)
+
+	_, err := getter.GetPlantByName("Thuja ocidentalis")
+	require.ErrorIs(s.T(), err, plant.ErrPlantNotFound)
+
+	suggestions, err := getter.SuggestPlants("Thuja ocidentalis", 5)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), suggestions)
+	assert.Equal(s.T(), "Thuja occidentalis", suggestions[0].GetName())
+
+	suggestions, err = getter.SuggestPlants("pinus silvestris", 1)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), suggestions, 1)
+	assert.Equal(s.T(), "Pinus sylvestris", suggestions[0].GetName())
+}
@@ -55,25 +55,64 @@ type PostPhoto struct {
 	PlaceNumber int
 }
 
-func (repo *PostgresSearchRepository) SearchPosts(ctx context.Context, srch *search.PostSearch) ([]*post.Post, error) {
+func (repo *PostgresSearchRepository) postWhere(srch *search.PostSearch) (squirrel.Sqlizer, error) {
 	whereClause, err := filters.NewPostgresPostSearch()
 	if err != nil {
-		return nil, fmt.Errorf("PostgresSearchRepository.SearchPosts failed %w", err)
+		return nil, err
 	}
 
-	srch.Iterate(func(pf search.PostFilter) error {
+	err = srch.Iterate(func(pf search.PostFilter) error {
 		filt, err := filters.MapPostFilter(pf)
 		if err != nil {
-			return fmt.Errorf("PostgresSearchRepository.SearchPosts failed %w", err)
+			return err
 		}
 		return whereClause.AddFilter(filt)
 	})
+	if err != nil {
+		return nil, err
+	}
+	return whereClause, nil
+}
+
+func (repo *PostgresSearchRepository) CountPosts(ctx context.Context, srch *search.PostSearch) (int, error) {
+	whereClause, err := repo.postWhere(srch)
+	if err != nil {
+		return 0, fmt.Errorf("PostgresSearchRepository.CountPosts failed %w", err)
+	}
+	row, err := repo.db.QueryRow(ctx,
+		squirrel.Select("COUNT(*)").
+			From("post").
+			Where(whereClause),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("PostgresSearchRepository.CountPosts failed %w", err)
+	}
+	var count int
+	if err := row.Scan(&count); err != nil {
+		return 0, fmt.Errorf("PostgresSearchRepository.CountPosts failed %w", err)
+	}
+	return count, nil
+}
 
-	rows, err := repo.db.Query(ctx,
+func (repo *PostgresSearchRepository) SearchPosts(ctx context.Context, srch *search.PostSearch) ([]*post.Post, error) {
+	whereClause, err := repo.postWhere(srch)
+	if err != nil {
+		return nil, fmt.Errorf("PostgresSearchRepository.SearchPosts failed %w", err)
+	}
+
+	order, err := postOrder(srch)
+	if err != nil {
+		return nil, fmt.Errorf("PostgresSearchRepository.SearchPosts failed %w", err)
+	}
+
+	query := paginate(
 		squirrel.Select("id", "title", "body", "author_id", "content_type", "updated_at", "created_at").
 			From("post").
 			Where(whereClause),
+		order, srch.Page(),
 	)
+
+	rows, err := repo.db.Query(ctx, query)
 	if errors.Is(err, sqdb.ErrNoRows) {
 		return nil, post.ErrPostNotFound
 	} else if err != nil {
@@ -197,10 +236,10 @@ type PlantPhoto struct {
 	Description string
 }
 
-func (repo *PostgresSearchRepository) SearchPlants(ctx context.Context, srch *search.PlantSearch) ([]*plant.Plant, error) {
+func (repo *PostgresSearchRepository) plantWhere(srch *search.PlantSearch) (squirrel.Sqlizer, error) {
 	whereClause, err := filters.NewPostgresPlantSearch()
 	if err != nil {
-		return nil, fmt.Errorf("PostgresSearchRepository.SearchPlants failed %w", err)
+		return nil, err
 	}
 
 	err = srch.Iterate(func(pf search.PlantFilter) error {
@@ -210,16 +249,51 @@ func (repo *PostgresSearchRepository) SearchPlants(ctx context.Context, srch *se
 		}
 		return whereClause.AddFilter(filt)
 	})
+	if err != nil {
+		return nil, err
+	}
+	return whereClause, nil
+}
 
+func (repo *PostgresSearchRepository) CountPlants(ctx context.Context, srch *search.PlantSearch) (int, error) {
+	whereClause, err := repo.plantWhere(srch)
+	if err != nil {
+		return 0, fmt.Errorf("PostgresSearchRepository.CountPlants failed %w", err)
+	}
+	row, err := repo.db.QueryRow(ctx,
+		squirrel.Select("COUNT(*)").
+			From("plant").
+			Where(whereClause),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("PostgresSearchRepository.CountPlants failed %w", err)
+	}
+	var count int
+	if err := row.Scan(&count); err != nil {
+		return 0, fmt.Errorf("PostgresSearchRepository.CountPlants failed %w", err)
+	}
+	return count, nil
+}
+
+func (repo *PostgresSearchRepository) SearchPlants(ctx context.Context, srch *search.PlantSearch) ([]*plant.Plant, error) {
+	whereClause, err := repo.plantWhere(srch)
+	if err != nil {
+		return nil, fmt.Errorf("PostgresSearchRepository.SearchPlants failed %w", err)
+	}
+
+	order, err := plantOrder(srch)
 	if err != nil {
 		return nil, fmt.Errorf("PostgresSearchRepository.SearchPlants failed %w", err)
 	}
 
-	rows, err := repo.db.Query(ctx,
+	query := paginate(
 		squirrel.Select("id", "name", "latin_name", "description", "main_photo_id", "category", "updated_at", "created_at", "specification").
 			From("plant").
 			Where(whereClause),
+		order, srch.Page(),
 	)
+
+	rows, err := repo.db.Query(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("PostgresSearchRepository.SearchPlants failed %w", err)
 	}
@@ -278,7 +352,8 @@ func (repo *PostgresSearchRepository) SearchPlants(ctx context.Context, srch *se
 func (repo *PostgresSearchRepository) fetchPlantPhotos(ctx context.Context, plantID uuid.UUID) (*plant.PlantPhotos, error) {
 	rows, err := repo.db.Query(ctx, squirrel.Select("id", "file_id", "description").
 		From("plant_photo").
-		Where(squirrel.Eq{"plant_id": plantID}),
+		Where(squirrel.Eq{"plant_id": plantID}).
+		OrderBy("place_number"),
 	)
 	if err != nil {
 		return nil, err
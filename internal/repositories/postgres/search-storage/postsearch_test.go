@@ -161,3 +161,29 @@ func (s *SearchRepositoryTestSuite) TestSearchPostsWithMultipleFilters() {
 	assert.Equal(s.T(), "Gardening Tips", posts[0].Title())
 	assert.Contains(s.T(), posts[0].Tags(), "tips")
 }
+
+func (s *SearchRepositoryTestSuite) TestSearchPostsByText() {
+	ctx := context.Background()
+
+	post1 := s.createTestPost(ctx)
+	post1.UpdateTitle("Watering roses")
+
+	post2 := s.createTestPost(ctx)
+	post2.UpdateTitle("Poliv roz")
+
+	_, err := s.postRepo.Create(ctx, post1)
+	require.NoError(s.T(), err)
+	_, err = s.postRepo.Create(ctx, post2)
+	require.NoError(s.T(), err)
+
+	// english stemming: "rose" matches "roses"
+	srch := search.NewPostSearch()
+	srch.AddFilter(search.NewPostTextFilter("rose"))
+	require.NoError(s.T(), srch.SetSort(search.Sort{Key: search.SortByRelevance}))
+
+	posts, err := s.searchRepo.SearchPosts(ctx, srch)
+	require.NoError(s.T(), err)
+
+	assert.Len(s.T(), posts, 1)
+	assert.Equal(s.T(), "Watering roses", posts[0].Title())
+}
@@ -0,0 +1,154 @@
+package sessionstorage
+
+import (
+	"PlantSite/internal/infra/sqdb"
+	authservice "PlantSite/internal/services/auth-service"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+var _ authservice.SessionStorage = (*PostgresSessionStorage)(nil)
+
+// PostgresSessionStorage keeps sessions in the database,
+// so they are shared between API replicas and survive restarts.
+type PostgresSessionStorage struct {
+	db sqdb.SquirrelDatabase
+}
+
+var sessionColumns = []string{"id", "member_id", "expires_at", "created_at", "remember", "last_seen_at", "user_agent", "ip"}
+
+func scanSession(row sqdb.Row) (*authservice.Session, error) {
+	var session authservice.Session
+	err := row.Scan(&session.ID, &session.MemberID, &session.ExpiresAt, &session.CreatedAt, &session.Remember,
+		&session.LastSeenAt, &session.UserAgent, &session.IP)
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+func NewPostgresSessionStorage(_ context.Context, db sqdb.SquirrelDatabase) (*PostgresSessionStorage, error) {
+	return &PostgresSessionStorage{db: db}, nil
+}
+
+func (storage *PostgresSessionStorage) Get(ctx context.Context, sid uuid.UUID) (*authservice.Session, error) {
+	row, err := storage.db.QueryRow(ctx,
+		squirrel.Select(sessionColumns...).
+			From(`"session"`).
+			Where(squirrel.Eq{"id": sid}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("PostgresSessionStorage.Get failed %w", err)
+	}
+
+	session, err := scanSession(row)
+	if err == sqdb.ErrNoRows {
+		return nil, authservice.ErrSessionNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("PostgresSessionStorage.Get failed %w", err)
+	}
+
+	if session.ExpiresAt.Before(time.Now()) {
+		storage.Delete(ctx, sid)
+		return nil, authservice.ErrSessionExpired
+	}
+	return session, nil
+}
+
+func (storage *PostgresSessionStorage) Store(ctx context.Context, sid uuid.UUID, session *authservice.Session) error {
+	_, err := storage.db.Insert(ctx,
+		squirrel.Insert(`"session"`).
+			Columns(sessionColumns...).
+			Values(sid, session.MemberID, session.ExpiresAt, session.CreatedAt, session.Remember,
+				session.LastSeenAt, session.UserAgent, session.IP).
+			Suffix("ON CONFLICT (id) DO UPDATE SET member_id = ?, expires_at = ?, last_seen_at = ?, ip = ?",
+				session.MemberID, session.ExpiresAt, session.LastSeenAt, session.IP),
+	)
+	if err != nil {
+		return fmt.Errorf("PostgresSessionStorage.Store failed %w", err)
+	}
+	return nil
+}
+
+func (storage *PostgresSessionStorage) Touch(ctx context.Context, sid uuid.UUID, session *authservice.Session) error {
+	_, err := storage.db.Update(ctx,
+		squirrel.Update(`"session"`).
+			Set("expires_at", session.ExpiresAt).
+			Set("last_seen_at", session.LastSeenAt).
+			Set("ip", session.IP).
+			Where(squirrel.Eq{"id": sid}),
+	)
+	if errors.Is(err, sqdb.ErrNoRows) {
+		return authservice.ErrSessionNotFound
+	} else if err != nil {
+		return fmt.Errorf("PostgresSessionStorage.Touch failed %w", err)
+	}
+	return nil
+}
+
+func (storage *PostgresSessionStorage) Delete(ctx context.Context, sid uuid.UUID) error {
+	_, err := storage.db.Delete(ctx,
+		squirrel.Delete(`"session"`).
+			Where(squirrel.Eq{"id": sid}),
+	)
+	if err != nil {
+		return fmt.Errorf("PostgresSessionStorage.Delete failed %w", err)
+	}
+	return nil
+}
+
+func (storage *PostgresSessionStorage) ListByMember(ctx context.Context, memberID uuid.UUID) ([]*authservice.Session, error) {
+	rows, err := storage.db.Query(ctx,
+		squirrel.Select(sessionColumns...).
+			From(`"session"`).
+			Where(squirrel.Eq{"member_id": memberID}).
+			Where(squirrel.GtOrEq{"expires_at": time.Now()}).
+			OrderBy("last_seen_at DESC"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("PostgresSessionStorage.ListByMember failed %w", err)
+	}
+	defer rows.Close()
+
+	sessions := make([]*authservice.Session, 0)
+	for rows.Next() {
+		session, err := scanSession(rows)
+		if err != nil {
+			return nil, fmt.Errorf("PostgresSessionStorage.ListByMember failed %w", err)
+		}
+		sessions = append(sessions, session)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("PostgresSessionStorage.ListByMember failed %w", err)
+	}
+	return sessions, nil
+}
+
+func (storage *PostgresSessionStorage) DeleteByMember(ctx context.Context, memberID uuid.UUID, except uuid.UUID) error {
+	_, err := storage.db.Delete(ctx,
+		squirrel.Delete(`"session"`).
+			Where(squirrel.Eq{"member_id": memberID}).
+			Where(squirrel.NotEq{"id": except}),
+	)
+	// The member may have no other sessions
+	if err != nil && !errors.Is(err, sqdb.ErrNoRows) {
+		return fmt.Errorf("PostgresSessionStorage.DeleteByMember failed %w", err)
+	}
+	return nil
+}
+
+func (storage *PostgresSessionStorage) ClearExpired(ctx context.Context) error {
+	_, err := storage.db.Delete(ctx,
+		squirrel.Delete(`"session"`).
+			Where(squirrel.Lt{"expires_at": time.Now()}),
+	)
+	if err != nil {
+		return fmt.Errorf("PostgresSessionStorage.ClearExpired failed %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,271 @@
+//go:build integration
+
+package sessionstorage_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"PlantSite/internal/infra/sqpgx"
+	sessionstorage "PlantSite/internal/repositories/postgres/session-storage"
+	"PlantSite/internal/repositories/tests"
+	authservice "PlantSite/internal/services/auth-service"
+	"PlantSite/internal/testutils/pgtest"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type SessionStorageTestSuite struct {
+	suite.Suite
+	container testcontainers.Container
+	db        *sqpgx.SquirrelPgx
+	storage   *sessionstorage.PostgresSessionStorage
+	prevDir   string
+}
+
+func TestSessionStorageSuite(t *testing.T) {
+	suite.Run(t, new(SessionStorageTestSuite))
+}
+
+func (s *SessionStorageTestSuite) SetupSuite() {
+	ctx := context.Background()
+
+	// Save current directory
+	prevDir, err := os.Getwd()
+	require.NoError(s.T(), err)
+	s.prevDir = prevDir
+
+	// Change directory to test working directory
+	err = os.Chdir(tests.GetTestWorkingDir())
+	require.NoError(s.T(), err)
+
+	// Create new container
+	container, creds, err := pgtest.NewTestPostgres(ctx)
+	require.NoError(s.T(), err)
+	s.container = container
+
+	// Run migrations
+	err = pgtest.Migrate(ctx, &creds)
+	require.NoError(s.T(), err)
+
+	// Create database connection
+	config := &sqpgx.SqpgxConfig{
+		User:                   creds.User,
+		Password:               creds.Password,
+		DbName:                 creds.Database,
+		Host:                   creds.Host,
+		Port:                   creds.Port,
+		MaxConnections:         10,
+		MaxConnectionsLifetime: time.Minute,
+	}
+
+	db, err := sqpgx.NewSquirrelPgx(ctx, config)
+	require.NoError(s.T(), err)
+	s.db = db
+
+	// Create storage
+	storage, err := sessionstorage.NewPostgresSessionStorage(ctx, db)
+	require.NoError(s.T(), err)
+	s.storage = storage
+}
+
+func (s *SessionStorageTestSuite) TearDownSuite() {
+	if s.container != nil {
+		s.container.Terminate(context.Background())
+	}
+	err := os.Chdir(s.prevDir)
+	require.NoError(s.T(), err)
+}
+
+func (s *SessionStorageTestSuite) createTestSession(expiresAt time.Time) *authservice.Session {
+	session, err := authservice.NewSession(uuid.New(), uuid.New(), expiresAt)
+	require.NoError(s.T(), err)
+	return session
+}
+
+func (s *SessionStorageTestSuite) TestStoreAndGet() {
+	ctx := context.Background()
+	session := s.createTestSession(time.Now().Add(time.Hour))
+
+	err := s.storage.Store(ctx, session.ID, session)
+	require.NoError(s.T(), err)
+
+	got, err := s.storage.Get(ctx, session.ID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), session.ID, got.ID)
+	require.Equal(s.T(), session.MemberID, got.MemberID)
+	require.WithinDuration(s.T(), session.ExpiresAt, got.ExpiresAt, time.Millisecond)
+	require.WithinDuration(s.T(), session.CreatedAt, got.CreatedAt, time.Millisecond)
+	require.False(s.T(), got.Remember)
+}
+
+func (s *SessionStorageTestSuite) TestStoreRemember() {
+	ctx := context.Background()
+	session := s.createTestSession(time.Now().Add(time.Hour))
+	session.Remember = true
+	require.NoError(s.T(), s.storage.Store(ctx, session.ID, session))
+
+	got, err := s.storage.Get(ctx, session.ID)
+	require.NoError(s.T(), err)
+	require.True(s.T(), got.Remember)
+}
+
+func (s *SessionStorageTestSuite) TestStoreClient() {
+	ctx := context.Background()
+	session := s.createTestSession(time.Now().Add(time.Hour))
+	session.UserAgent = "Firefox"
+	session.IP = "10.0.0.1"
+	require.NoError(s.T(), s.storage.Store(ctx, session.ID, session))
+
+	session.LastSeenAt = time.Now().Add(time.Minute)
+	session.IP = "10.0.0.2"
+	require.NoError(s.T(), s.storage.Store(ctx, session.ID, session))
+
+	got, err := s.storage.Get(ctx, session.ID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), "Firefox", got.UserAgent)
+	require.Equal(s.T(), "10.0.0.2", got.IP)
+	require.WithinDuration(s.T(), session.LastSeenAt, got.LastSeenAt, time.Millisecond)
+}
+
+func (s *SessionStorageTestSuite) TestStoreOverwrites() {
+	ctx := context.Background()
+	session := s.createTestSession(time.Now().Add(time.Hour))
+	require.NoError(s.T(), s.storage.Store(ctx, session.ID, session))
+
+	session.ExpiresAt = time.Now().Add(2 * time.Hour)
+	require.NoError(s.T(), s.storage.Store(ctx, session.ID, session))
+
+	got, err := s.storage.Get(ctx, session.ID)
+	require.NoError(s.T(), err)
+	require.WithinDuration(s.T(), session.ExpiresAt, got.ExpiresAt, time.Millisecond)
+}
+
+func (s *SessionStorageTestSuite) TestTouch() {
+	ctx := context.Background()
+	session := s.createTestSession(time.Now().Add(time.Hour))
+	session.UserAgent = "Firefox"
+	require.NoError(s.T(), s.storage.Store(ctx, session.ID, session))
+
+	session.ExpiresAt = time.Now().Add(2 * time.Hour)
+	session.LastSeenAt = time.Now().Add(time.Minute)
+	session.IP = "10.0.0.2"
+	require.NoError(s.T(), s.storage.Touch(ctx, session.ID, session))
+
+	got, err := s.storage.Get(ctx, session.ID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), "Firefox", got.UserAgent)
+	require.Equal(s.T(), "10.0.0.2", got.IP)
+	require.WithinDuration(s.T(), session.ExpiresAt, got.ExpiresAt, time.Millisecond)
+	require.WithinDuration(s.T(), session.LastSeenAt, got.LastSeenAt, time.Millisecond)
+}
+
+func (s *SessionStorageTestSuite) TestTouchDeleted() {
+	ctx := context.Background()
+	session := s.createTestSession(time.Now().Add(time.Hour))
+	require.NoError(s.T(), s.storage.Store(ctx, session.ID, session))
+	require.NoError(s.T(), s.storage.Delete(ctx, session.ID))
+
+	err := s.storage.Touch(ctx, session.ID, session)
+	require.ErrorIs(s.T(), err, authservice.ErrSessionNotFound)
+
+	_, err = s.storage.Get(ctx, session.ID)
+	require.ErrorIs(s.T(), err, authservice.ErrSessionNotFound)
+}
+
+func (s *SessionStorageTestSuite) TestGetNotFound() {
+	_, err := s.storage.Get(context.Background(), uuid.New())
+	require.ErrorIs(s.T(), err, authservice.ErrSessionNotFound)
+}
+
+func (s *SessionStorageTestSuite) TestGetExpired() {
+	ctx := context.Background()
+	session := s.createTestSession(time.Now().Add(-time.Minute))
+	require.NoError(s.T(), s.storage.Store(ctx, session.ID, session))
+
+	_, err := s.storage.Get(ctx, session.ID)
+	require.ErrorIs(s.T(), err, authservice.ErrSessionExpired)
+
+	_, err = s.storage.Get(ctx, session.ID)
+	require.ErrorIs(s.T(), err, authservice.ErrSessionNotFound)
+}
+
+func (s *SessionStorageTestSuite) TestDelete() {
+	ctx := context.Background()
+	session := s.createTestSession(time.Now().Add(time.Hour))
+	require.NoError(s.T(), s.storage.Store(ctx, session.ID, session))
+
+	require.NoError(s.T(), s.storage.Delete(ctx, session.ID))
+
+	_, err := s.storage.Get(ctx, session.ID)
+	require.ErrorIs(s.T(), err, authservice.ErrSessionNotFound)
+}
+
+func (s *SessionStorageTestSuite) TestDeleteByMember() {
+	ctx := context.Background()
+	current := s.createTestSession(time.Now().Add(time.Hour))
+	other := s.createTestSession(time.Now().Add(time.Hour))
+	other.MemberID = current.MemberID
+	foreign := s.createTestSession(time.Now().Add(time.Hour))
+	for _, session := range []*authservice.Session{current, other, foreign} {
+		require.NoError(s.T(), s.storage.Store(ctx, session.ID, session))
+	}
+
+	require.NoError(s.T(), s.storage.DeleteByMember(ctx, current.MemberID, current.ID))
+
+	_, err := s.storage.Get(ctx, current.ID)
+	require.NoError(s.T(), err)
+	_, err = s.storage.Get(ctx, other.ID)
+	require.ErrorIs(s.T(), err, authservice.ErrSessionNotFound)
+	_, err = s.storage.Get(ctx, foreign.ID)
+	require.NoError(s.T(), err)
+}
+
+func (s *SessionStorageTestSuite) TestDeleteByMemberWithoutSessions() {
+	require.NoError(s.T(), s.storage.DeleteByMember(context.Background(), uuid.New(), uuid.Nil))
+}
+
+func (s *SessionStorageTestSuite) TestListByMember() {
+	ctx := context.Background()
+	older := s.createTestSession(time.Now().Add(time.Hour))
+	older.LastSeenAt = time.Now().Add(-time.Hour)
+	newer := s.createTestSession(time.Now().Add(time.Hour))
+	newer.MemberID = older.MemberID
+	expired := s.createTestSession(time.Now().Add(-time.Minute))
+	expired.MemberID = older.MemberID
+	foreign := s.createTestSession(time.Now().Add(time.Hour))
+	for _, session := range []*authservice.Session{older, newer, expired, foreign} {
+		require.NoError(s.T(), s.storage.Store(ctx, session.ID, session))
+	}
+
+	sessions, err := s.storage.ListByMember(ctx, older.MemberID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), sessions, 2)
+	require.Equal(s.T(), newer.ID, sessions[0].ID)
+	require.Equal(s.T(), older.ID, sessions[1].ID)
+}
+
+func (s *SessionStorageTestSuite) TestClearExpired() {
+	ctx := context.Background()
+	expired := s.createTestSession(time.Now().Add(-time.Minute))
+	active := s.createTestSession(time.Now().Add(time.Hour))
+	require.NoError(s.T(), s.storage.Store(ctx, expired.ID, expired))
+	require.NoError(s.T(), s.storage.Store(ctx, active.ID, active))
+
+	require.NoError(s.T(), s.storage.ClearExpired(ctx))
+
+	row, err := s.db.QueryRow(ctx, squirrel.Select("COUNT(*)").From(`"session"`).Where(squirrel.Eq{"id": expired.ID}))
+	require.NoError(s.T(), err)
+	var count int
+	require.NoError(s.T(), row.Scan(&count))
+	require.Zero(s.T(), count)
+
+	_, err = s.storage.Get(ctx, active.ID)
+	require.NoError(s.T(), err)
+}
@@ -0,0 +1,79 @@
+package tokenstorage
+
+import (
+	"PlantSite/internal/infra/sqdb"
+	authservice "PlantSite/internal/services/auth-service"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+)
+
+var _ authservice.MemberTokenStorage = (*PostgresMemberTokenStorage)(nil)
+
+const (
+	PasswordResetTable     = "password_reset_token"
+	EmailVerificationTable = "email_verification_token"
+)
+
+// PostgresMemberTokenStorage keeps tokens of one purpose in its own table.
+type PostgresMemberTokenStorage struct {
+	db    sqdb.SquirrelDatabase
+	table string
+}
+
+func NewPostgresMemberTokenStorage(_ context.Context, db sqdb.SquirrelDatabase, table string) (*PostgresMemberTokenStorage, error) {
+	if table != PasswordResetTable && table != EmailVerificationTable {
+		return nil, fmt.Errorf("unknown token table %q", table)
+	}
+	return &PostgresMemberTokenStorage{db: db, table: table}, nil
+}
+
+func (storage *PostgresMemberTokenStorage) Store(ctx context.Context, token *authservice.MemberToken) error {
+	_, err := storage.db.Insert(ctx,
+		squirrel.Insert(storage.table).
+			Columns("token_hash", "member_id", "expires_at").
+			Values(token.TokenHash, token.MemberID, token.ExpiresAt),
+	)
+	if err != nil {
+		return fmt.Errorf("PostgresMemberTokenStorage.Store failed %w", err)
+	}
+	return nil
+}
+
+func (storage *PostgresMemberTokenStorage) Consume(ctx context.Context, tokenHash []byte) (*authservice.MemberToken, error) {
+	// DELETE ... RETURNING makes concurrent uses of the same token see it only once
+	row, err := storage.db.QueryRow(ctx,
+		squirrel.Select("token_hash", "member_id", "expires_at").
+			Prefix("WITH consumed AS (?)",
+				squirrel.Delete(storage.table).
+					Where(squirrel.Eq{"token_hash": tokenHash}).
+					Suffix("RETURNING token_hash, member_id, expires_at"),
+			).
+			From("consumed"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("PostgresMemberTokenStorage.Consume failed %w", err)
+	}
+
+	var token authservice.MemberToken
+	err = row.Scan(&token.TokenHash, &token.MemberID, &token.ExpiresAt)
+	if err == sqdb.ErrNoRows {
+		return nil, authservice.ErrInvalidToken
+	} else if err != nil {
+		return nil, fmt.Errorf("PostgresMemberTokenStorage.Consume failed %w", err)
+	}
+	return &token, nil
+}
+
+func (storage *PostgresMemberTokenStorage) ClearExpired(ctx context.Context) error {
+	_, err := storage.db.Delete(ctx,
+		squirrel.Delete(storage.table).
+			Where(squirrel.Lt{"expires_at": time.Now()}),
+	)
+	if err != nil {
+		return fmt.Errorf("PostgresMemberTokenStorage.ClearExpired failed %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+//go:build integration
+
+package tokenstorage_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"PlantSite/internal/infra/sqpgx"
+	"PlantSite/internal/models/auth"
+	authstorage "PlantSite/internal/repositories/postgres/auth-storage"
+	tokenstorage "PlantSite/internal/repositories/postgres/token-storage"
+	"PlantSite/internal/repositories/tests"
+	authservice "PlantSite/internal/services/auth-service"
+	"PlantSite/internal/testutils/pgtest"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type MemberTokenStorageTestSuite struct {
+	suite.Suite
+	container testcontainers.Container
+	db        *sqpgx.SquirrelPgx
+	storage   *tokenstorage.PostgresMemberTokenStorage
+	userRepo  *authstorage.PostgresAuthRepository
+	prevDir   string
+}
+
+func TestMemberTokenStorageSuite(t *testing.T) {
+	suite.Run(t, new(MemberTokenStorageTestSuite))
+}
+
+func (s *MemberTokenStorageTestSuite) SetupSuite() {
+	ctx := context.Background()
+
+	// Save current directory
+	prevDir, err := os.Getwd()
+	require.NoError(s.T(), err)
+	s.prevDir = prevDir
+
+	// Change directory to test working directory
+	err = os.Chdir(tests.GetTestWorkingDir())
+	require.NoError(s.T(), err)
+
+	// Create new container
+	container, creds, err := pgtest.NewTestPostgres(ctx)
+	require.NoError(s.T(), err)
+	s.container = container
+
+	// Run migrations
+	err = pgtest.Migrate(ctx, &creds)
+	require.NoError(s.T(), err)
+
+	// Create database connection
+	config := &sqpgx.SqpgxConfig{
+		User:                   creds.User,
+		Password:               creds.Password,
+		DbName:                 creds.Database,
+		Host:                   creds.Host,
+		Port:                   creds.Port,
+		MaxConnections:         10,
+		MaxConnectionsLifetime: time.Minute,
+	}
+
+	db, err := sqpgx.NewSquirrelPgx(ctx, config)
+	require.NoError(s.T(), err)
+	s.db = db
+
+	// Create storages
+	storage, err := tokenstorage.NewPostgresMemberTokenStorage(ctx, db, tokenstorage.PasswordResetTable)
+	require.NoError(s.T(), err)
+	s.storage = storage
+
+	s.userRepo, err = authstorage.NewPostgresAuthRepository(ctx, db)
+	require.NoError(s.T(), err)
+}
+
+func (s *MemberTokenStorageTestSuite) TearDownSuite() {
+	if s.container != nil {
+		s.container.Terminate(context.Background())
+	}
+	err := os.Chdir(s.prevDir)
+	require.NoError(s.T(), err)
+}
+
+func (s *MemberTokenStorageTestSuite) pushTestToken(expiresAt time.Time) *authservice.MemberToken {
+	ctx := context.Background()
+	member, err := auth.NewMember(uuid.NewString()[:8], uuid.NewString()[:8]+"@example.com", []byte("hash"))
+	require.NoError(s.T(), err)
+	_, err = s.userRepo.Create(ctx, member)
+	require.NoError(s.T(), err)
+
+	hash := uuid.New()
+	token := &authservice.MemberToken{
+		TokenHash: hash[:],
+		MemberID:  member.ID(),
+		ExpiresAt: expiresAt,
+	}
+	require.NoError(s.T(), s.storage.Store(ctx, token))
+	return token
+}
+
+func (s *MemberTokenStorageTestSuite) TestConsume() {
+	ctx := context.Background()
+	token := s.pushTestToken(time.Now().Add(time.Hour))
+
+	got, err := s.storage.Consume(ctx, token.TokenHash)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), token.TokenHash, got.TokenHash)
+	require.Equal(s.T(), token.MemberID, got.MemberID)
+	require.WithinDuration(s.T(), token.ExpiresAt, got.ExpiresAt, time.Millisecond)
+
+	_, err = s.storage.Consume(ctx, token.TokenHash)
+	require.ErrorIs(s.T(), err, authservice.ErrInvalidToken)
+}
+
+func (s *MemberTokenStorageTestSuite) TestConsumeUnknown() {
+	hash := uuid.New()
+	_, err := s.storage.Consume(context.Background(), hash[:])
+	require.ErrorIs(s.T(), err, authservice.ErrInvalidToken)
+}
+
+func (s *MemberTokenStorageTestSuite) TestClearExpired() {
+	ctx := context.Background()
+	expired := s.pushTestToken(time.Now().Add(-time.Minute))
+	active := s.pushTestToken(time.Now().Add(time.Hour))
+
+	require.NoError(s.T(), s.storage.ClearExpired(ctx))
+
+	row, err := s.db.QueryRow(ctx, squirrel.Select("COUNT(*)").From("password_reset_token").Where(squirrel.Eq{"token_hash": expired.TokenHash}))
+	require.NoError(s.T(), err)
+	var count int
+	require.NoError(s.T(), row.Scan(&count))
+	require.Zero(s.T(), count)
+
+	_, err = s.storage.Consume(ctx, active.TokenHash)
+	require.NoError(s.T(), err)
+}
@@ -0,0 +1,25 @@
+package adminservice
+
+import "fmt"
+
+type AdminServiceError struct {
+	msg string
+	err error
+}
+
+func (e AdminServiceError) Error() string {
+	return fmt.Sprintf("admin service error: %v", e.msg)
+}
+
+func (e AdminServiceError) Unwrap() error {
+	return e.err
+}
+
+func Wrap(e error) AdminServiceError {
+	return AdminServiceError{msg: e.Error(), err: e}
+}
+
+var (
+	ErrNotAnAuthor       = AdminServiceError{msg: "user is not an author"}
+	ErrRightsNotEditable = AdminServiceError{msg: "author rights of the user can't be changed"}
+)
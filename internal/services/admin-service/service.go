@@ -0,0 +1,163 @@
+package adminservice
+
+import (
+	"PlantSite/internal/models/auth"
+	authservice "PlantSite/internal/services/auth-service"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AdminService struct {
+	members auth.MemberRepository
+	users   auth.AuthRepository
+	auth    *authservice.AuthService
+}
+
+func NewAdminService(members auth.MemberRepository, users auth.AuthRepository, auth *authservice.AuthService) *AdminService {
+	if members == nil {
+		panic("nil member repository")
+	}
+	if users == nil {
+		panic("nil auth repository")
+	}
+	if auth == nil {
+		panic("nil auth")
+	}
+	return &AdminService{
+		members: members,
+		users:   users,
+		auth:    auth,
+	}
+}
+
+type MemberInfo struct {
+	ID              uuid.UUID
+	Name            string
+	Email           string
+	CreatedAt       time.Time
+	IsAuthor        bool
+	HasAuthorRights bool
+}
+
+func (s *AdminService) checkAdmin(ctx context.Context) error {
+	if !s.auth.UserFromContext(ctx).IsAuthenticated() {
+		return auth.ErrNotAuthorized
+	}
+	_, err := s.auth.Authorize(ctx, auth.PermMemberManage)
+	return err
+}
+
+// checkEditable makes sure that the author rights of the user are stored
+// in the repository, those who manage members always have them.
+func (s *AdminService) checkEditable(ctx context.Context, id uuid.UUID) error {
+	user, err := s.users.Get(ctx, id)
+	if err != nil {
+		return Wrap(err)
+	}
+	if authservice.Policy.Can(user, auth.PermMemberManage) {
+		return ErrRightsNotEditable
+	}
+	return nil
+}
+
+func (s *AdminService) ListMembers(ctx context.Context, offset, limit int) ([]MemberInfo, error) {
+	if err := s.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	users, err := s.members.List(ctx, offset, limit)
+	if err != nil {
+		return nil, Wrap(err)
+	}
+	members := make([]MemberInfo, 0, len(users))
+	for _, user := range users {
+		switch fact := user.(type) {
+		case *auth.Member:
+			members = append(members, MemberInfo{
+				ID:        fact.ID(),
+				Name:      fact.Name(),
+				Email:     fact.Email(),
+				CreatedAt: fact.CreatedAt(),
+			})
+		case *auth.Author:
+			members = append(members, MemberInfo{
+				ID:              fact.ID(),
+				Name:            fact.Name(),
+				Email:           fact.Email(),
+				CreatedAt:       fact.CreatedAt(),
+				IsAuthor:        true,
+				HasAuthorRights: fact.HasRights(),
+			})
+		}
+	}
+	return members, nil
+}
+
+// GrantAuthorRights promotes a member to author or restores revoked author rights.
+func (s *AdminService) GrantAuthorRights(ctx context.Context, id uuid.UUID) error {
+	if err := s.checkAdmin(ctx); err != nil {
+		return err
+	}
+	if err := s.checkEditable(ctx, id); err != nil {
+		return err
+	}
+	_, err := s.users.Update(ctx, id, func(user auth.User) (auth.User, error) {
+		switch fact := user.(type) {
+		case *auth.Member:
+			return auth.CreateAuthor(*fact, time.Now(), true, time.Time{})
+		case *auth.Author:
+			if !fact.HasRights() {
+				fact.GrantRights(true)
+			}
+			return fact, nil
+		default:
+			return nil, ErrRightsNotEditable
+		}
+	})
+	if err != nil {
+		return Wrap(err)
+	}
+	return nil
+}
+
+func (s *AdminService) RevokeAuthorRights(ctx context.Context, id uuid.UUID) error {
+	if err := s.checkAdmin(ctx); err != nil {
+		return err
+	}
+	if err := s.checkEditable(ctx, id); err != nil {
+		return err
+	}
+	_, err := s.users.Update(ctx, id, func(user auth.User) (auth.User, error) {
+		switch fact := user.(type) {
+		case *auth.Member:
+			return nil, ErrNotAnAuthor
+		case *auth.Author:
+			if fact.HasRights() {
+				fact.RevokeAuthorRights()
+			}
+			return fact, nil
+		default:
+			return nil, ErrRightsNotEditable
+		}
+	})
+	if err != nil {
+		return Wrap(err)
+	}
+	return nil
+}
+
+// AuthorRightsHistory returns grants and revokes of the member author rights, oldest first.
+func (s *AdminService) AuthorRightsHistory(ctx context.Context, id uuid.UUID) ([]auth.AuthorRightsChange, error) {
+	if err := s.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := s.users.Get(ctx, id); err != nil {
+		return nil, Wrap(err)
+	}
+	history, err := s.members.AuthorRightsHistory(ctx, id)
+	if err != nil {
+		return nil, Wrap(err)
+	}
+	return history, nil
+}
@@ -0,0 +1,169 @@
+package adminservice_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"PlantSite/internal/models/auth"
+	adminservice "PlantSite/internal/services/admin-service"
+	authmock "PlantSite/internal/services/auth-service/auth-mock"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newAdmin(t *testing.T) *auth.Admin {
+	admin, err := auth.NewAdmin("admin", []byte("hash"))
+	require.NoError(t, err)
+	return admin
+}
+
+func newMember(t *testing.T) *auth.Member {
+	member, err := auth.CreateMember(uuid.New(), "member", "member@example.com", []byte("hash"), time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	return member
+}
+
+// runUpdate makes the mocked repository apply the update function to the user.
+func runUpdate(ctx context.Context, users *authmock.MockAuthRepository, user auth.User, result *auth.User, resultErr *error) {
+	users.On("Update", ctx, user.ID(), mock.Anything).
+		Run(func(args mock.Arguments) {
+			updateFn := args.Get(2).(func(auth.User) (auth.User, error))
+			*result, *resultErr = updateFn(user)
+		}).
+		Return(user, nil)
+}
+
+func TestListMembers(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctx, asvc, users := authmock.Authenticate(newAdmin(t))
+		memberRepo := new(authmock.MockMemberRepository)
+		svc := adminservice.NewAdminService(memberRepo, users, asvc)
+		member := newMember(t)
+		author, err := auth.CreateAuthor(*newMember(t), time.Now(), true, time.Time{})
+		require.NoError(t, err)
+		memberRepo.On("List", ctx, 0, 10).Return([]auth.User{member, author}, nil)
+
+		members, err := svc.ListMembers(ctx, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, members, 2)
+		assert.Equal(t, member.Name(), members[0].Name)
+		assert.False(t, members[0].IsAuthor)
+		assert.True(t, members[1].IsAuthor)
+		assert.True(t, members[1].HasAuthorRights)
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		author, err := auth.CreateAuthor(*newMember(t), time.Now(), true, time.Time{})
+		require.NoError(t, err)
+		ctx, asvc, users := authmock.Authenticate(author)
+		memberRepo := new(authmock.MockMemberRepository)
+		svc := adminservice.NewAdminService(memberRepo, users, asvc)
+
+		_, err = svc.ListMembers(ctx, 0, 10)
+		require.ErrorIs(t, err, auth.ErrNoAdminRights)
+		memberRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestGrantAuthorRights(t *testing.T) {
+	t.Run("PromoteMember", func(t *testing.T) {
+		ctx, asvc, users := authmock.Authenticate(newAdmin(t))
+		svc := adminservice.NewAdminService(new(authmock.MockMemberRepository), users, asvc)
+		member := newMember(t)
+		users.On("Get", ctx, member.ID()).Return(member, nil)
+		var result auth.User
+		var resultErr error
+		runUpdate(ctx, users, member, &result, &resultErr)
+
+		require.NoError(t, svc.GrantAuthorRights(ctx, member.ID()))
+		require.NoError(t, resultErr)
+		author, ok := result.(*auth.Author)
+		require.True(t, ok)
+		assert.True(t, author.HasRights())
+	})
+
+	t.Run("RestoreRevoked", func(t *testing.T) {
+		ctx, asvc, users := authmock.Authenticate(newAdmin(t))
+		svc := adminservice.NewAdminService(new(authmock.MockMemberRepository), users, asvc)
+		author, err := auth.CreateAuthor(*newMember(t), time.Now().Add(-time.Minute), true, time.Time{})
+		require.NoError(t, err)
+		author.RevokeAuthorRights()
+		users.On("Get", ctx, author.ID()).Return(author, nil)
+		var result auth.User
+		var resultErr error
+		runUpdate(ctx, users, author, &result, &resultErr)
+
+		require.NoError(t, svc.GrantAuthorRights(ctx, author.ID()))
+		require.NoError(t, resultErr)
+		assert.True(t, result.HasAuthorRights())
+	})
+
+	t.Run("AdminTarget", func(t *testing.T) {
+		admin := newAdmin(t)
+		ctx, asvc, users := authmock.Authenticate(admin)
+		svc := adminservice.NewAdminService(new(authmock.MockMemberRepository), users, asvc)
+
+		err := svc.GrantAuthorRights(ctx, admin.ID())
+		require.ErrorIs(t, err, adminservice.ErrRightsNotEditable)
+		users.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("NotAuthorized", func(t *testing.T) {
+		_, asvc, users := authmock.Authenticate(newAdmin(t))
+		svc := adminservice.NewAdminService(new(authmock.MockMemberRepository), users, asvc)
+
+		err := svc.GrantAuthorRights(context.Background(), uuid.New())
+		require.ErrorIs(t, err, auth.ErrNotAuthorized)
+	})
+}
+
+func TestRevokeAuthorRights(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctx, asvc, users := authmock.Authenticate(newAdmin(t))
+		svc := adminservice.NewAdminService(new(authmock.MockMemberRepository), users, asvc)
+		author, err := auth.CreateAuthor(*newMember(t), time.Now().Add(-time.Minute), true, time.Time{})
+		require.NoError(t, err)
+		users.On("Get", ctx, author.ID()).Return(author, nil)
+		var result auth.User
+		var resultErr error
+		runUpdate(ctx, users, author, &result, &resultErr)
+
+		require.NoError(t, svc.RevokeAuthorRights(ctx, author.ID()))
+		require.NoError(t, resultErr)
+		assert.False(t, result.HasAuthorRights())
+	})
+
+	t.Run("NotAnAuthor", func(t *testing.T) {
+		ctx, asvc, users := authmock.Authenticate(newAdmin(t))
+		svc := adminservice.NewAdminService(new(authmock.MockMemberRepository), users, asvc)
+		member := newMember(t)
+		users.On("Get", ctx, member.ID()).Return(member, nil)
+		var result auth.User
+		var resultErr error
+		runUpdate(ctx, users, member, &result, &resultErr)
+
+		require.NoError(t, svc.RevokeAuthorRights(ctx, member.ID()))
+		require.ErrorIs(t, resultErr, adminservice.ErrNotAnAuthor)
+	})
+}
+
+func TestAuthorRightsHistory(t *testing.T) {
+	ctx, asvc, users := authmock.Authenticate(newAdmin(t))
+	memberRepo := new(authmock.MockMemberRepository)
+	svc := adminservice.NewAdminService(memberRepo, users, asvc)
+	member := newMember(t)
+	history := []auth.AuthorRightsChange{
+		{HasRights: true, ChangedAt: time.Now().Add(-time.Hour)},
+		{HasRights: false, ChangedAt: time.Now()},
+	}
+	users.On("Get", ctx, member.ID()).Return(member, nil)
+	memberRepo.On("AuthorRightsHistory", ctx, member.ID()).Return(history, nil)
+
+	result, err := svc.AuthorRightsHistory(ctx, member.ID())
+	require.NoError(t, err)
+	assert.Equal(t, history, result)
+}
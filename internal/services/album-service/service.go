@@ -22,12 +22,12 @@ func NewAlbumService(repo album.AlbumRepository, auth *authservice.AuthService)
 }
 
 func (s *AlbumService) CreateAlbum(ctx context.Context, alb *album.Album) (*album.Album, error) {
-	user := s.auth.UserFromContext(ctx)
-	if user == nil {
-		return nil, auth.ErrNotAuthorized
+	user, err := s.auth.Authorize(ctx, auth.PermAlbumManage)
+	if err != nil {
+		return nil, err
 	}
-	if !user.HasMemberRights() {
-		return nil, auth.ErrNoMemberRights
+	if !auth.IsEmailVerified(user) {
+		return nil, auth.ErrEmailNotVerified
 	}
 	ownerAlb, err := album.CreateAlbum(
 		alb.ID(),
@@ -41,6 +41,10 @@ func (s *AlbumService) CreateAlbum(ctx context.Context, alb *album.Album) (*albu
 	if err != nil {
 		return nil, Wrap(err)
 	}
+	// The share link is made here, a link given by the client could be guessed
+	if err := ownerAlb.UpdateVisibility(alb.Visibility()); err != nil {
+		return nil, Wrap(err)
+	}
 	alb, err = s.albumRepository.Create(ctx, ownerAlb)
 	if err != nil {
 		return nil, Wrap(err)
@@ -48,33 +52,84 @@ func (s *AlbumService) CreateAlbum(ctx context.Context, alb *album.Album) (*albu
 	return alb, nil
 }
 
+// Reader is who the user of the request reads albums as.
+func (s *AlbumService) Reader(ctx context.Context) album.Reader {
+	return reader(s.auth.UserFromContext(ctx))
+}
+
+func reader(user auth.User) album.Reader {
+	rdr := album.Reader{ReadAny: authservice.Policy.Can(user, auth.PermAlbumReadAny)}
+	if authservice.Policy.Can(user, auth.PermAlbumRead) {
+		rdr.ID = user.ID()
+	}
+	return rdr
+}
+
+// GetAlbum returns a public album to anyone, other albums only to the owner.
+// Unlisted albums are read by others with GetSharedAlbum.
 func (s *AlbumService) GetAlbum(ctx context.Context, id uuid.UUID) (*album.Album, error) {
 	user := s.auth.UserFromContext(ctx)
-	if user == nil {
-		return nil, auth.ErrNotAuthorized
-	}
-	if !user.HasMemberRights() {
-		return nil, auth.ErrNoMemberRights
-	}
 	alb, err := s.albumRepository.Get(ctx, id)
 	if err != nil {
 		return nil, Wrap(err)
 	}
-	if alb.GetOwnerID() != user.ID() {
+	if !reader(user).CanRead(alb) {
+		if err := authservice.Policy.Authorize(user, auth.PermAlbumRead); err != nil {
+			return nil, err
+		}
 		return nil, ErrNotOwner
 	}
 	return alb, nil
 }
 
-func (s *AlbumService) UpdateAlbumName(ctx context.Context, id uuid.UUID, name string) error {
-	user := s.auth.UserFromContext(ctx)
-	if user == nil {
-		return auth.ErrNotAuthorized
+// GetSharedAlbum returns the unlisted album of the share link to anyone.
+func (s *AlbumService) GetSharedAlbum(ctx context.Context, shareToken string) (*album.Album, error) {
+	if shareToken == "" {
+		return nil, Wrap(album.ErrAlbumNotFound)
+	}
+	alb, err := s.albumRepository.GetByShareToken(ctx, shareToken)
+	if err != nil {
+		return nil, Wrap(err)
+	}
+	if !(album.Reader{ShareToken: shareToken}).CanRead(alb) {
+		return nil, Wrap(album.ErrAlbumNotFound)
+	}
+	return alb, nil
+}
+
+func (s *AlbumService) ListPublicAlbums(ctx context.Context) ([]*album.Album, error) {
+	albs, err := s.albumRepository.ListPublic(ctx)
+	if err != nil {
+		return nil, Wrap(err)
+	}
+	return albs, nil
+}
+
+// UpdateAlbumVisibility returns the updated album, making an album unlisted gives it a new share link.
+func (s *AlbumService) UpdateAlbumVisibility(ctx context.Context, id uuid.UUID, visibility album.Visibility) (*album.Album, error) {
+	user, err := s.auth.Authorize(ctx, auth.PermAlbumManage)
+	if err != nil {
+		return nil, err
 	}
-	if !user.HasMemberRights() {
-		return auth.ErrNoMemberRights
+	alb, err := s.albumRepository.Update(ctx, id, func(a *album.Album) (*album.Album, error) {
+		if a.GetOwnerID() != user.ID() {
+			return nil, ErrNotOwner
+		}
+		err := a.UpdateVisibility(visibility)
+		return a, err
+	})
+	if err != nil {
+		return nil, Wrap(err)
 	}
-	_, err := s.albumRepository.Update(ctx, id, func(a *album.Album) (*album.Album, error) {
+	return alb, nil
+}
+
+func (s *AlbumService) UpdateAlbumName(ctx context.Context, id uuid.UUID, name string) error {
+	user, err := s.auth.Authorize(ctx, auth.PermAlbumManage)
+	if err != nil {
+		return err
+	}
+	_, err = s.albumRepository.Update(ctx, id, func(a *album.Album) (*album.Album, error) {
 		if a.GetOwnerID() != user.ID() {
 			return nil, ErrNotOwner
 		}
@@ -88,14 +143,11 @@ func (s *AlbumService) UpdateAlbumName(ctx context.Context, id uuid.UUID, name s
 }
 
 func (s *AlbumService) UpdateAlbumDescription(ctx context.Context, id uuid.UUID, description string) error {
-	user := s.auth.UserFromContext(ctx)
-	if user == nil {
-		return auth.ErrNotAuthorized
-	}
-	if !user.HasMemberRights() {
-		return auth.ErrNoMemberRights
+	user, err := s.auth.Authorize(ctx, auth.PermAlbumManage)
+	if err != nil {
+		return err
 	}
-	_, err := s.albumRepository.Update(ctx, id, func(a *album.Album) (*album.Album, error) {
+	_, err = s.albumRepository.Update(ctx, id, func(a *album.Album) (*album.Album, error) {
 		if a.GetOwnerID() != user.ID() {
 			return nil, ErrNotOwner
 		}
@@ -109,14 +161,11 @@ func (s *AlbumService) UpdateAlbumDescription(ctx context.Context, id uuid.UUID,
 }
 
 func (s *AlbumService) AddPlantToAlbum(ctx context.Context, id uuid.UUID, plantID uuid.UUID) error {
-	user := s.auth.UserFromContext(ctx)
-	if user == nil {
-		return auth.ErrNotAuthorized
-	}
-	if !user.HasMemberRights() {
-		return auth.ErrNoMemberRights
+	user, err := s.auth.Authorize(ctx, auth.PermAlbumManage)
+	if err != nil {
+		return err
 	}
-	_, err := s.albumRepository.Update(ctx, id, func(a *album.Album) (*album.Album, error) {
+	_, err = s.albumRepository.Update(ctx, id, func(a *album.Album) (*album.Album, error) {
 		if a.GetOwnerID() != user.ID() {
 			return nil, ErrNotOwner
 		}
@@ -130,14 +179,11 @@ func (s *AlbumService) AddPlantToAlbum(ctx context.Context, id uuid.UUID, plantI
 }
 
 func (s *AlbumService) RemovePlantFromAlbum(ctx context.Context, id uuid.UUID, plantID uuid.UUID) error {
-	user := s.auth.UserFromContext(ctx)
-	if user == nil {
-		return auth.ErrNotAuthorized
-	}
-	if !user.HasMemberRights() {
-		return auth.ErrNoMemberRights
+	user, err := s.auth.Authorize(ctx, auth.PermAlbumManage)
+	if err != nil {
+		return err
 	}
-	_, err := s.albumRepository.Update(ctx, id, func(a *album.Album) (*album.Album, error) {
+	_, err = s.albumRepository.Update(ctx, id, func(a *album.Album) (*album.Album, error) {
 		if a.GetOwnerID() != user.ID() {
 			return nil, ErrNotOwner
 		}
@@ -151,12 +197,9 @@ func (s *AlbumService) RemovePlantFromAlbum(ctx context.Context, id uuid.UUID, p
 }
 
 func (s *AlbumService) DeleteAlbum(ctx context.Context, id uuid.UUID) error {
-	user := s.auth.UserFromContext(ctx)
-	if user == nil {
-		return auth.ErrNotAuthorized
-	}
-	if !user.HasMemberRights() {
-		return auth.ErrNoMemberRights
+	user, err := s.auth.Authorize(ctx, auth.PermAlbumManage)
+	if err != nil {
+		return err
 	}
 	alb, err := s.albumRepository.Get(ctx, id)
 	if err != nil {
@@ -173,12 +216,9 @@ func (s *AlbumService) DeleteAlbum(ctx context.Context, id uuid.UUID) error {
 }
 
 func (s *AlbumService) ListAlbums(ctx context.Context) ([]*album.Album, error) {
-	user := s.auth.UserFromContext(ctx)
-	if user == nil {
-		return nil, auth.ErrNotAuthorized
-	}
-	if !user.HasMemberRights() {
-		return nil, auth.ErrNoMemberRights
+	user, err := s.auth.Authorize(ctx, auth.PermAlbumRead)
+	if err != nil {
+		return nil, err
 	}
 	albs, err := s.albumRepository.List(ctx, user.ID())
 	if err != nil {
@@ -55,6 +55,19 @@ func (m *MockAlbumRepository) List(ctx context.Context, ownerID uuid.UUID) ([]*a
 	return args.Get(0).([]*album.Album), args.Error(1)
 }
 
+func (m *MockAlbumRepository) GetByShareToken(ctx context.Context, shareToken string) (*album.Album, error) {
+	args := m.Called(ctx, shareToken)
+	if args.Get(0) != nil {
+		return args.Get(0).(*album.Album), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockAlbumRepository) ListPublic(ctx context.Context) ([]*album.Album, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*album.Album), args.Error(1)
+}
+
 func TestAlbumService(t *testing.T) {
 	ctx := context.Background()
 	validAlbumID := uuid.New()
@@ -79,6 +92,7 @@ func TestAlbumService(t *testing.T) {
 			user := new(authmock.MockUser)
 			user.On("ID").Return(validOwnerID)
 			user.On("HasMemberRights").Return(true)
+			user.On("EmailVerified").Return(true)
 			sessions.On("Get", ctx, validSessionID).Return(validSession, nil)
 			ctx := asvc.Authenticate(ctx, validSessionID)
 			arepo.On("Get", ctx, validOwnerID).Return(user, nil)
@@ -134,6 +148,33 @@ func TestAlbumService(t *testing.T) {
 			_, err := svc.CreateAlbum(ctx, validAlbum)
 			assert.ErrorIs(t, err, auth.ErrNoMemberRights)
 		})
+
+		t.Run("EmailNotVerified", func(t *testing.T) {
+			arepo := new(authmock.MockAuthRepository)
+			sessions := new(authmock.MockSessionStorage)
+			hasher := new(authmock.MockPasswdHasher)
+			asvc := authservice.NewAuthService(sessions, arepo, hasher)
+			validSession := &authservice.Session{
+				ID:        validSessionID,
+				MemberID:  validOwnerID,
+				ExpiresAt: time.Now().Add(time.Hour),
+			}
+			user := new(authmock.MockUser)
+			user.On("ID").Return(validOwnerID)
+			user.On("HasMemberRights").Return(true)
+			user.On("EmailVerified").Return(false)
+			sessions.On("Get", ctx, validSessionID).Return(validSession, nil)
+			ctx := asvc.Authenticate(ctx, validSessionID)
+			arepo.On("Get", ctx, validOwnerID).Return(user, nil)
+
+			repo := new(MockAlbumRepository)
+
+			svc := albumservice.NewAlbumService(repo, asvc)
+
+			_, err := svc.CreateAlbum(ctx, validAlbum)
+			assert.ErrorIs(t, err, auth.ErrEmailNotVerified)
+			repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
 	})
 
 	t.Run("GetAlbum", func(t *testing.T) {
@@ -565,4 +606,137 @@ func TestAlbumService(t *testing.T) {
 			assert.Empty(t, result)
 		})
 	})
+
+	t.Run("Visibility", func(t *testing.T) {
+		newAlbum := func(t *testing.T, visibility album.Visibility) *album.Album {
+			alb, err := album.NewAlbum("Shared Album", "Shared Description", uuid.UUIDs{validPlantID}, validOwnerID)
+			require.NoError(t, err)
+			require.NoError(t, alb.UpdateVisibility(visibility))
+			return alb
+		}
+		// authenticate returns the context of a request of the member
+		authenticate := func(t *testing.T, memberID uuid.UUID) (*authservice.AuthService, context.Context) {
+			arepo := new(authmock.MockAuthRepository)
+			sessions := new(authmock.MockSessionStorage)
+			hasher := new(authmock.MockPasswdHasher)
+			asvc := authservice.NewAuthService(sessions, arepo, hasher)
+			validSession := &authservice.Session{
+				ID:        validSessionID,
+				MemberID:  memberID,
+				ExpiresAt: time.Now().Add(time.Hour),
+			}
+			user := new(authmock.MockUser)
+			user.On("ID").Return(memberID)
+			user.On("HasMemberRights").Return(true)
+			sessions.On("Get", ctx, validSessionID).Return(validSession, nil)
+			ctx := asvc.Authenticate(ctx, validSessionID)
+			arepo.On("Get", ctx, memberID).Return(user, nil)
+			return asvc, ctx
+		}
+		anonymous := func() *authservice.AuthService {
+			return authservice.NewAuthService(new(authmock.MockSessionStorage), new(authmock.MockAuthRepository), new(authmock.MockPasswdHasher))
+		}
+
+		t.Run("GetPublicAnonymous", func(t *testing.T) {
+			alb := newAlbum(t, album.VisibilityPublic)
+			repo := new(MockAlbumRepository)
+			repo.On("Get", mock.Anything, alb.ID()).Return(alb, nil)
+			svc := albumservice.NewAlbumService(repo, anonymous())
+
+			result, err := svc.GetAlbum(ctx, alb.ID())
+			require.NoError(t, err)
+			assert.Equal(t, alb, result)
+		})
+
+		t.Run("GetPrivateAnonymous", func(t *testing.T) {
+			alb := newAlbum(t, album.VisibilityPrivate)
+			repo := new(MockAlbumRepository)
+			repo.On("Get", mock.Anything, alb.ID()).Return(alb, nil)
+			svc := albumservice.NewAlbumService(repo, anonymous())
+
+			_, err := svc.GetAlbum(ctx, alb.ID())
+			require.Error(t, err)
+			assert.NotErrorIs(t, err, albumservice.ErrNotOwner)
+		})
+
+		t.Run("GetUnlistedNotOwner", func(t *testing.T) {
+			alb := newAlbum(t, album.VisibilityUnlisted)
+			asvc, ctx := authenticate(t, uuid.New())
+			repo := new(MockAlbumRepository)
+			repo.On("Get", mock.Anything, alb.ID()).Return(alb, nil)
+			svc := albumservice.NewAlbumService(repo, asvc)
+
+			_, err := svc.GetAlbum(ctx, alb.ID())
+			assert.ErrorIs(t, err, albumservice.ErrNotOwner)
+		})
+
+		t.Run("GetShared", func(t *testing.T) {
+			alb := newAlbum(t, album.VisibilityUnlisted)
+			repo := new(MockAlbumRepository)
+			repo.On("GetByShareToken", mock.Anything, alb.ShareToken()).Return(alb, nil)
+			svc := albumservice.NewAlbumService(repo, anonymous())
+
+			result, err := svc.GetSharedAlbum(ctx, alb.ShareToken())
+			require.NoError(t, err)
+			assert.Equal(t, alb, result)
+		})
+
+		t.Run("GetSharedNotFound", func(t *testing.T) {
+			repo := new(MockAlbumRepository)
+			repo.On("GetByShareToken", mock.Anything, "revoked").Return(nil, album.ErrAlbumNotFound)
+			svc := albumservice.NewAlbumService(repo, anonymous())
+
+			_, err := svc.GetSharedAlbum(ctx, "revoked")
+			assert.ErrorIs(t, err, album.ErrAlbumNotFound)
+			_, err = svc.GetSharedAlbum(ctx, "")
+			assert.ErrorIs(t, err, album.ErrAlbumNotFound)
+		})
+
+		t.Run("ListPublic", func(t *testing.T) {
+			expectedAlbums := []*album.Album{newAlbum(t, album.VisibilityPublic)}
+			repo := new(MockAlbumRepository)
+			repo.On("ListPublic", mock.Anything).Return(expectedAlbums, nil)
+			svc := albumservice.NewAlbumService(repo, anonymous())
+
+			result, err := svc.ListPublicAlbums(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, expectedAlbums, result)
+		})
+
+		t.Run("UpdateUnlisted", func(t *testing.T) {
+			alb := newAlbum(t, album.VisibilityPrivate)
+			asvc, ctx := authenticate(t, validOwnerID)
+			repo := new(MockAlbumRepository)
+			repo.On("Update", mock.Anything, alb.ID(), mock.Anything).Return(alb, nil)
+			svc := albumservice.NewAlbumService(repo, asvc)
+
+			result, err := svc.UpdateAlbumVisibility(ctx, alb.ID(), album.VisibilityUnlisted)
+			require.NoError(t, err)
+			assert.Equal(t, album.VisibilityUnlisted, result.Visibility())
+			assert.NotEmpty(t, result.ShareToken())
+		})
+
+		t.Run("UpdateNotOwner", func(t *testing.T) {
+			alb := newAlbum(t, album.VisibilityPrivate)
+			asvc, ctx := authenticate(t, uuid.New())
+			repo := new(MockAlbumRepository)
+			repo.On("Update", mock.Anything, alb.ID(), mock.Anything).Return(alb, nil)
+			svc := albumservice.NewAlbumService(repo, asvc)
+
+			_, err := svc.UpdateAlbumVisibility(ctx, alb.ID(), album.VisibilityPublic)
+			assert.ErrorIs(t, err, albumservice.ErrNotOwner)
+			assert.Equal(t, album.VisibilityPrivate, alb.Visibility())
+		})
+
+		t.Run("UpdateInvalid", func(t *testing.T) {
+			alb := newAlbum(t, album.VisibilityPrivate)
+			asvc, ctx := authenticate(t, validOwnerID)
+			repo := new(MockAlbumRepository)
+			repo.On("Update", mock.Anything, alb.ID(), mock.Anything).Return(alb, nil)
+			svc := albumservice.NewAlbumService(repo, asvc)
+
+			_, err := svc.UpdateAlbumVisibility(ctx, alb.ID(), "friends")
+			assert.ErrorIs(t, err, album.ErrInvalidVisibility)
+		})
+	})
 }
@@ -0,0 +1,60 @@
+package authservice
+
+import (
+	"PlantSite/internal/models/auth"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// sessionOwner returns the user of the context whose sessions can be managed,
+// a stolen token must not be able to end the sessions of its owner.
+func (s *AuthService) sessionOwner(ctx context.Context) (auth.User, error) {
+	user := s.UserFromContext(ctx)
+	if !user.IsAuthenticated() {
+		return nil, auth.ErrNotAuthorized
+	}
+	if auth.IsScoped(user) {
+		return nil, ErrSessionRequired
+	}
+	return user, nil
+}
+
+// CurrentSessionID returns the session the request is authenticated with, uuid.Nil for other requests.
+func (s *AuthService) CurrentSessionID(ctx context.Context) uuid.UUID {
+	return s.sessionFromContext(ctx)
+}
+
+// ListSessions returns the active sessions of the user, most recently used first.
+func (s *AuthService) ListSessions(ctx context.Context) ([]*Session, error) {
+	user, err := s.sessionOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.sessions.ListByMember(ctx, user.ID())
+}
+
+// RevokeSession ends a session of the user, returns ErrSessionNotFound for sessions of other users.
+func (s *AuthService) RevokeSession(ctx context.Context, sid uuid.UUID) error {
+	user, err := s.sessionOwner(ctx)
+	if err != nil {
+		return err
+	}
+	session, err := s.sessions.Get(ctx, sid)
+	if err != nil {
+		return err
+	}
+	if session.MemberID != user.ID() {
+		return ErrSessionNotFound
+	}
+	return s.sessions.Delete(ctx, sid)
+}
+
+// RevokeOtherSessions ends all sessions of the user but the current one.
+func (s *AuthService) RevokeOtherSessions(ctx context.Context) error {
+	user, err := s.sessionOwner(ctx)
+	if err != nil {
+		return err
+	}
+	return s.sessions.DeleteByMember(ctx, user.ID(), s.sessionFromContext(ctx))
+}
@@ -0,0 +1,127 @@
+package authservice_test
+
+import (
+	"context"
+	"testing"
+
+	apitokenstorage "PlantSite/internal/infra/api-token-storage"
+	sessionstorage "PlantSite/internal/infra/session-storage"
+	"PlantSite/internal/models/auth"
+	authservice "PlantSite/internal/services/auth-service"
+	authmock "PlantSite/internal/services/auth-service/auth-mock"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActiveSessions(t *testing.T) {
+	ctx := context.Background()
+	password := "securepassword"
+	member, err := auth.NewMember("test", "test@example.com", []byte("hash"))
+	require.NoError(t, err)
+	other, err := auth.NewMember("other", "other@example.com", []byte("hash"))
+	require.NoError(t, err)
+
+	type env struct {
+		svc      *authservice.AuthService
+		sessions *sessionstorage.MapSessionStorage
+	}
+
+	newEnv := func(t *testing.T) *env {
+		e := &env{sessions: sessionstorage.NewMapSessionStorage()}
+		repo := new(authmock.MockAuthRepository)
+		hasher := new(authmock.MockPasswdHasher)
+		hasher.On("Compare", []byte("hash"), []byte(password)).Return(true, nil)
+		repo.On("Get", mock.Anything, member.ID()).Return(member, nil)
+		repo.On("Get", mock.Anything, other.ID()).Return(other, nil)
+		repo.On("GetByEmail", mock.Anything, member.Email()).Return(member, nil)
+		repo.On("GetByEmail", mock.Anything, other.Email()).Return(other, nil)
+		e.svc = authservice.NewAuthService(e.sessions, repo, hasher)
+		return e
+	}
+
+	// login signs in from the client and returns the context of its next request
+	login := func(t *testing.T, e *env, email string, client authservice.Client) (uuid.UUID, context.Context) {
+		clientCtx := authservice.WithClient(ctx, client)
+		sid, err := e.svc.Login(clientCtx, email, password, false)
+		require.NoError(t, err)
+		return sid, e.svc.Authenticate(clientCtx, sid)
+	}
+
+	t.Run("List", func(t *testing.T) {
+		e := newEnv(t)
+		laptop, laptopCtx := login(t, e, member.Email(), authservice.Client{UserAgent: "Firefox", IP: "10.0.0.1"})
+		phone, _ := login(t, e, member.Email(), authservice.Client{UserAgent: "Safari", IP: "10.0.0.2"})
+		login(t, e, other.Email(), authservice.Client{UserAgent: "Chrome", IP: "10.0.0.3"})
+
+		sessions, err := e.svc.ListSessions(laptopCtx)
+		require.NoError(t, err)
+		require.Len(t, sessions, 2)
+		byID := map[uuid.UUID]*authservice.Session{}
+		for _, session := range sessions {
+			byID[session.ID] = session
+		}
+		require.Contains(t, byID, laptop)
+		require.Contains(t, byID, phone)
+		assert.Equal(t, "Firefox", byID[laptop].UserAgent)
+		assert.Equal(t, "10.0.0.2", byID[phone].IP)
+		assert.Equal(t, laptop, e.svc.CurrentSessionID(laptopCtx))
+	})
+
+	t.Run("Revoke", func(t *testing.T) {
+		e := newEnv(t)
+		_, laptopCtx := login(t, e, member.Email(), authservice.Client{})
+		phone, _ := login(t, e, member.Email(), authservice.Client{})
+
+		require.NoError(t, e.svc.RevokeSession(laptopCtx, phone))
+		_, err := e.sessions.Get(ctx, phone)
+		require.ErrorIs(t, err, authservice.ErrSessionNotFound)
+		require.ErrorIs(t, e.svc.RevokeSession(laptopCtx, phone), authservice.ErrSessionNotFound)
+	})
+
+	t.Run("RevokeForeign", func(t *testing.T) {
+		e := newEnv(t)
+		_, laptopCtx := login(t, e, member.Email(), authservice.Client{})
+		foreign, _ := login(t, e, other.Email(), authservice.Client{})
+
+		require.ErrorIs(t, e.svc.RevokeSession(laptopCtx, foreign), authservice.ErrSessionNotFound)
+		_, err := e.sessions.Get(ctx, foreign)
+		require.NoError(t, err)
+	})
+
+	t.Run("RevokeOthers", func(t *testing.T) {
+		e := newEnv(t)
+		laptop, laptopCtx := login(t, e, member.Email(), authservice.Client{})
+		phone, _ := login(t, e, member.Email(), authservice.Client{})
+		foreign, _ := login(t, e, other.Email(), authservice.Client{})
+
+		require.NoError(t, e.svc.RevokeOtherSessions(laptopCtx))
+		_, err := e.sessions.Get(ctx, laptop)
+		require.NoError(t, err)
+		_, err = e.sessions.Get(ctx, phone)
+		require.ErrorIs(t, err, authservice.ErrSessionNotFound)
+		_, err = e.sessions.Get(ctx, foreign)
+		require.NoError(t, err)
+	})
+
+	t.Run("NotAuthorized", func(t *testing.T) {
+		e := newEnv(t)
+		_, err := e.svc.ListSessions(ctx)
+		require.ErrorIs(t, err, auth.ErrNotAuthorized)
+	})
+
+	t.Run("NoManagementByToken", func(t *testing.T) {
+		e := newEnv(t)
+		_, laptopCtx := login(t, e, member.Email(), authservice.Client{})
+		tokens := authservice.NewAPITokenService(apitokenstorage.NewMapAPITokenStorage(), e.svc)
+		_, token, err := tokens.Create(laptopCtx, "script", auth.ScopeAuthor)
+		require.NoError(t, err)
+
+		tokenCtx := tokens.Authenticate(ctx, token)
+		_, err = e.svc.ListSessions(tokenCtx)
+		require.ErrorIs(t, err, authservice.ErrSessionRequired)
+		require.ErrorIs(t, e.svc.RevokeOtherSessions(tokenCtx), authservice.ErrSessionRequired)
+	})
+}
@@ -0,0 +1,125 @@
+package authservice
+
+import (
+	"PlantSite/internal/models/auth"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// apiTokenPrefix makes personal tokens recognizable in configs and secret scanners.
+const apiTokenPrefix = "pp_"
+
+// APIToken is a personal access token of a member for scripts using the API.
+// Like mailed tokens, it is stored by its hash only.
+type APIToken struct {
+	ID        uuid.UUID
+	MemberID  uuid.UUID
+	Name      string
+	Scope     auth.TokenScope
+	TokenHash []byte
+	CreatedAt time.Time
+}
+
+type APITokenStorage interface {
+	Store(ctx context.Context, token *APIToken) error
+	GetByHash(ctx context.Context, tokenHash []byte) (*APIToken, error)
+	List(ctx context.Context, memberID uuid.UUID) ([]*APIToken, error)
+	// Delete removes the token of the member, returns ErrAPITokenNotFound if the member has no such token.
+	Delete(ctx context.Context, memberID, id uuid.UUID) error
+}
+
+type APITokenService struct {
+	tokens APITokenStorage
+	auth   *AuthService
+}
+
+func NewAPITokenService(tokens APITokenStorage, auth *AuthService) *APITokenService {
+	if tokens == nil {
+		panic("nil tokens")
+	}
+	if auth == nil {
+		panic("nil auth")
+	}
+	return &APITokenService{
+		tokens: tokens,
+		auth:   auth,
+	}
+}
+
+// Authenticate is AuthService.Authenticate for Bearer tokens,
+// UserFromContext then returns the owner limited by the token scope.
+func (s *APITokenService) Authenticate(ctx context.Context, token string) context.Context {
+	userID := uuid.Nil
+	var scope auth.TokenScope
+	apiToken, err := s.tokens.GetByHash(ctx, hashMemberToken(token))
+	if err == nil {
+		userID = apiToken.MemberID
+		scope = apiToken.Scope
+	}
+
+	ctx = context.WithValue(ctx, AuthContextKey, userID)
+	ctx = context.WithValue(ctx, tokenScopeContextKey, scope)
+
+	return withRequestUser(ctx)
+}
+
+// sessionUser returns the user of the context, tokens can't be managed with tokens.
+func (s *APITokenService) sessionUser(ctx context.Context) (auth.User, error) {
+	user := s.auth.UserFromContext(ctx)
+	if !user.IsAuthenticated() {
+		return nil, auth.ErrNotAuthorized
+	}
+	if auth.IsScoped(user) {
+		return nil, ErrSessionRequired
+	}
+	return user, nil
+}
+
+// Create issues a token for the user of the context and returns it together with the token itself,
+// which is shown only once.
+func (s *APITokenService) Create(ctx context.Context, name string, scope auth.TokenScope) (*APIToken, string, error) {
+	user, err := s.sessionUser(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	if scope == auth.ScopeAdmin && !auth.IsAdmin(user) {
+		return nil, "", auth.ErrNoAdminRights
+	}
+
+	secret, err := newMemberToken()
+	if err != nil {
+		return nil, "", err
+	}
+	token := apiTokenPrefix + secret
+
+	apiToken := &APIToken{
+		ID:        uuid.New(),
+		MemberID:  user.ID(),
+		Name:      name,
+		Scope:     scope,
+		TokenHash: hashMemberToken(token),
+		CreatedAt: time.Now(),
+	}
+	if err := s.tokens.Store(ctx, apiToken); err != nil {
+		return nil, "", err
+	}
+	return apiToken, token, nil
+}
+
+func (s *APITokenService) List(ctx context.Context) ([]*APIToken, error) {
+	user, err := s.sessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.tokens.List(ctx, user.ID())
+}
+
+func (s *APITokenService) Revoke(ctx context.Context, id uuid.UUID) error {
+	user, err := s.sessionUser(ctx)
+	if err != nil {
+		return err
+	}
+	return s.tokens.Delete(ctx, user.ID(), id)
+}
@@ -0,0 +1,107 @@
+package authservice_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	apitokenstorage "PlantSite/internal/infra/api-token-storage"
+	"PlantSite/internal/models/auth"
+	authservice "PlantSite/internal/services/auth-service"
+	authmock "PlantSite/internal/services/auth-service/auth-mock"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPITokens(t *testing.T) {
+	ctx := context.Background()
+	member, err := auth.NewMember("test", "test@example.com", []byte("hash"))
+	require.NoError(t, err)
+	sid := uuid.New()
+
+	newService := func(t *testing.T) (*authservice.APITokenService, *authservice.AuthService, context.Context) {
+		repo := new(authmock.MockAuthRepository)
+		sessions := new(authmock.MockSessionStorage)
+		repo.On("Get", mock.Anything, member.ID()).Return(member, nil)
+		sessions.On("Get", mock.Anything, sid).Return(&authservice.Session{
+			ID:        sid,
+			MemberID:  member.ID(),
+			ExpiresAt: time.Now().Add(time.Hour),
+			CreatedAt: time.Now(),
+		}, nil)
+
+		asvc := authservice.NewAuthService(sessions, repo, new(authmock.MockPasswdHasher))
+		svc := authservice.NewAPITokenService(apitokenstorage.NewMapAPITokenStorage(), asvc)
+		return svc, asvc, asvc.Authenticate(ctx, sid)
+	}
+
+	t.Run("CreateAndAuthenticate", func(t *testing.T) {
+		svc, asvc, sessCtx := newService(t)
+
+		apiToken, token, err := svc.Create(sessCtx, "script", auth.ScopeReadOnly)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(token, "pp_"))
+		assert.Equal(t, member.ID(), apiToken.MemberID)
+		assert.NotContains(t, string(apiToken.TokenHash), token)
+
+		user := asvc.UserFromContext(svc.Authenticate(ctx, token))
+		require.True(t, user.IsAuthenticated())
+		assert.Equal(t, member.ID(), user.ID())
+		assert.True(t, auth.IsScoped(user))
+		assert.False(t, user.HasMemberRights())
+	})
+
+	t.Run("UnknownToken", func(t *testing.T) {
+		svc, asvc, _ := newService(t)
+
+		user := asvc.UserFromContext(svc.Authenticate(ctx, "pp_unknown"))
+		assert.False(t, user.IsAuthenticated())
+	})
+
+	t.Run("AdminScopeForMember", func(t *testing.T) {
+		svc, _, sessCtx := newService(t)
+
+		_, _, err := svc.Create(sessCtx, "script", auth.ScopeAdmin)
+		require.ErrorIs(t, err, auth.ErrNoAdminRights)
+	})
+
+	t.Run("NotAuthorized", func(t *testing.T) {
+		svc, _, _ := newService(t)
+
+		_, _, err := svc.Create(ctx, "script", auth.ScopeAuthor)
+		require.ErrorIs(t, err, auth.ErrNotAuthorized)
+	})
+
+	t.Run("NoManagementByToken", func(t *testing.T) {
+		svc, _, sessCtx := newService(t)
+		_, token, err := svc.Create(sessCtx, "script", auth.ScopeAuthor)
+		require.NoError(t, err)
+
+		tokenCtx := svc.Authenticate(ctx, token)
+		_, _, err = svc.Create(tokenCtx, "another", auth.ScopeAuthor)
+		require.ErrorIs(t, err, authservice.ErrSessionRequired)
+		_, err = svc.List(tokenCtx)
+		require.ErrorIs(t, err, authservice.ErrSessionRequired)
+	})
+
+	t.Run("ListAndRevoke", func(t *testing.T) {
+		svc, asvc, sessCtx := newService(t)
+		apiToken, token, err := svc.Create(sessCtx, "script", auth.ScopeAuthor)
+		require.NoError(t, err)
+
+		tokens, err := svc.List(sessCtx)
+		require.NoError(t, err)
+		require.Len(t, tokens, 1)
+		assert.Equal(t, apiToken.ID, tokens[0].ID)
+
+		require.NoError(t, svc.Revoke(sessCtx, apiToken.ID))
+		require.ErrorIs(t, svc.Revoke(sessCtx, apiToken.ID), authservice.ErrAPITokenNotFound)
+
+		user := asvc.UserFromContext(svc.Authenticate(ctx, token))
+		assert.False(t, user.IsAuthenticated())
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"PlantSite/internal/models/auth"
 	authservice "PlantSite/internal/services/auth-service"
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -19,6 +20,11 @@ func (m *MockSessionStorage) Store(ctx context.Context, sid uuid.UUID, session *
 	return args.Error(0)
 }
 
+func (m *MockSessionStorage) Touch(ctx context.Context, sid uuid.UUID, session *authservice.Session) error {
+	args := m.Called(ctx, sid, session)
+	return args.Error(0)
+}
+
 func (m *MockSessionStorage) Get(ctx context.Context, sid uuid.UUID) (*authservice.Session, error) {
 	args := m.Called(ctx, sid)
 	if args.Get(0) == nil {
@@ -32,6 +38,19 @@ func (m *MockSessionStorage) Delete(ctx context.Context, sid uuid.UUID) error {
 	return args.Error(0)
 }
 
+func (m *MockSessionStorage) ListByMember(ctx context.Context, memberID uuid.UUID) ([]*authservice.Session, error) {
+	args := m.Called(ctx, memberID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*authservice.Session), args.Error(1)
+}
+
+func (m *MockSessionStorage) DeleteByMember(ctx context.Context, memberID uuid.UUID, except uuid.UUID) error {
+	args := m.Called(ctx, memberID, except)
+	return args.Error(0)
+}
+
 func (m *MockSessionStorage) ClearExpired(ctx context.Context) error {
 	args := m.Called(ctx)
 	return args.Error(0)
@@ -82,6 +101,27 @@ func (m *MockAuthRepository) GetByEmail(ctx context.Context, email string) (auth
 	return args.Get(0).(auth.User), args.Error(1)
 }
 
+// MockMemberRepository implements auth.MemberRepository interface
+type MockMemberRepository struct {
+	mock.Mock
+}
+
+func (m *MockMemberRepository) List(ctx context.Context, offset, limit int) ([]auth.User, error) {
+	args := m.Called(ctx, offset, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]auth.User), args.Error(1)
+}
+
+func (m *MockMemberRepository) AuthorRightsHistory(ctx context.Context, id uuid.UUID) ([]auth.AuthorRightsChange, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]auth.AuthorRightsChange), args.Error(1)
+}
+
 // MockPasswdHasher implements PasswdHasher interface
 type MockPasswdHasher struct {
 	mock.Mock
@@ -143,3 +183,58 @@ func (m *MockUser) IsAuthenticated() bool {
 func (m *MockUser) Username() string {
 	return m.Called().String(0)
 }
+
+func (m *MockUser) EmailVerified() bool {
+	return m.Called().Bool(0)
+}
+
+// MockMemberTokenStorage implements MemberTokenStorage interface
+type MockMemberTokenStorage struct {
+	mock.Mock
+}
+
+func (m *MockMemberTokenStorage) Store(ctx context.Context, token *authservice.MemberToken) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+func (m *MockMemberTokenStorage) Consume(ctx context.Context, tokenHash []byte) (*authservice.MemberToken, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*authservice.MemberToken), args.Error(1)
+}
+
+func (m *MockMemberTokenStorage) ClearExpired(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+// MockMailer implements Mailer interface
+type MockMailer struct {
+	mock.Mock
+}
+
+func (m *MockMailer) Send(ctx context.Context, mail authservice.Mail) error {
+	args := m.Called(ctx, mail)
+	return args.Error(0)
+}
+
+// Authenticate returns the context of a request made by the user with a new auth service
+// that knows the session. The user repository is returned for more expectations.
+func Authenticate(user auth.User) (context.Context, *authservice.AuthService, *MockAuthRepository) {
+	ctx := context.Background()
+	sessionID := uuid.New()
+	users := new(MockAuthRepository)
+	sessions := new(MockSessionStorage)
+	asvc := authservice.NewAuthService(sessions, users, new(MockPasswdHasher))
+	sessions.On("Get", ctx, sessionID).Return(&authservice.Session{
+		ID:        sessionID,
+		MemberID:  user.ID(),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}, nil)
+	ctx = asvc.Authenticate(ctx, sessionID)
+	users.On("Get", ctx, user.ID()).Return(user, nil)
+	return ctx, asvc, users
+}
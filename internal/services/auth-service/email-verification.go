@@ -0,0 +1,75 @@
+package authservice
+
+import (
+	"PlantSite/internal/models/auth"
+	"context"
+	"fmt"
+)
+
+type EmailVerificationService struct {
+	tokens     MemberTokenStorage
+	repository auth.AuthRepository
+	mailer     Mailer
+}
+
+func NewEmailVerificationService(tokens MemberTokenStorage, repository auth.AuthRepository, mailer Mailer) *EmailVerificationService {
+	if tokens == nil {
+		panic("nil tokens")
+	}
+	if repository == nil {
+		panic("nil repository")
+	}
+	if mailer == nil {
+		panic("nil mailer")
+	}
+	return &EmailVerificationService{
+		tokens:     tokens,
+		repository: repository,
+		mailer:     mailer,
+	}
+}
+
+// SendVerification mails a verification link to the member with the email.
+// Unknown and already verified emails are skipped without an error,
+// so that the endpoint can't be used to find out registered emails.
+func (s *EmailVerificationService) SendVerification(ctx context.Context, email string) error {
+	user, err := s.repository.GetByEmail(ctx, email)
+	if err != nil {
+		return nil
+	}
+	if auth.IsEmailVerified(user) {
+		return nil
+	}
+
+	token, err := issueMemberToken(ctx, s.tokens, user.ID(), EmailVerificationTokenExpireTime)
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.Send(ctx, Mail{
+		To:      email,
+		Subject: "Email verification",
+		Body: fmt.Sprintf(
+			"Hello, %s!\n\nFollow the link to confirm your email: %s\n\nThe link expires in %v. If you didn't register, ignore this mail.\n",
+			user.Username(), tokenLink(EmailVerificationURL, token), EmailVerificationTokenExpireTime,
+		),
+	})
+}
+
+// VerifyEmail marks the email of the member the token was issued for as verified.
+func (s *EmailVerificationService) VerifyEmail(ctx context.Context, token string) error {
+	verificationToken, err := consumeMemberToken(ctx, s.tokens, token)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.repository.Update(ctx, verificationToken.MemberID, func(user auth.User) (auth.User, error) {
+		member, ok := user.(interface{ MarkEmailVerified() })
+		if !ok {
+			return nil, ErrInvalidToken
+		}
+		member.MarkEmailVerified()
+		return user, nil
+	})
+	return err
+}
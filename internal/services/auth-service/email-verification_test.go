@@ -0,0 +1,122 @@
+package authservice_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"net/url"
+	"testing"
+	"time"
+
+	"PlantSite/internal/models/auth"
+	authservice "PlantSite/internal/services/auth-service"
+	authmock "PlantSite/internal/services/auth-service/auth-mock"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailVerification(t *testing.T) {
+	ctx := context.Background()
+	email := "test@example.com"
+	tokenType := mock.AnythingOfType("*authservice.MemberToken")
+	updateFn := mock.AnythingOfType("func(auth.User) (auth.User, error)")
+
+	newMember := func(t *testing.T) *auth.Member {
+		member, err := auth.NewMember("test", email, []byte("hash"))
+		require.NoError(t, err)
+		return member
+	}
+
+	t.Run("SendToUnverified", func(t *testing.T) {
+		tokens := new(authmock.MockMemberTokenStorage)
+		repo := new(authmock.MockAuthRepository)
+		mailer := new(authmock.MockMailer)
+		member := newMember(t)
+
+		var stored *authservice.MemberToken
+		var sent authservice.Mail
+		repo.On("GetByEmail", ctx, email).Return(member, nil)
+		tokens.On("Store", ctx, tokenType).
+			Run(func(args mock.Arguments) { stored = args.Get(1).(*authservice.MemberToken) }).
+			Return(nil)
+		mailer.On("Send", ctx, mock.AnythingOfType("authservice.Mail")).
+			Run(func(args mock.Arguments) { sent = args.Get(1).(authservice.Mail) }).
+			Return(nil)
+
+		svc := authservice.NewEmailVerificationService(tokens, repo, mailer)
+		require.NoError(t, svc.SendVerification(ctx, email))
+
+		require.NotNil(t, stored)
+		assert.Equal(t, member.ID(), stored.MemberID)
+		assert.WithinDuration(t, time.Now().Add(authservice.EmailVerificationTokenExpireTime), stored.ExpiresAt, time.Second)
+		assert.Equal(t, email, sent.To)
+
+		link, err := url.Parse(resetLinkRegexp.FindString(sent.Body))
+		require.NoError(t, err)
+		assert.Equal(t, authservice.EmailVerificationURL, link.Path)
+		hash := sha256.Sum256([]byte(link.Query().Get("token")))
+		assert.Equal(t, hash[:], stored.TokenHash)
+	})
+
+	t.Run("SkipVerified", func(t *testing.T) {
+		tokens := new(authmock.MockMemberTokenStorage)
+		repo := new(authmock.MockAuthRepository)
+		mailer := new(authmock.MockMailer)
+		member := newMember(t)
+		member.MarkEmailVerified()
+		repo.On("GetByEmail", ctx, email).Return(member, nil)
+
+		svc := authservice.NewEmailVerificationService(tokens, repo, mailer)
+		require.NoError(t, svc.SendVerification(ctx, email))
+		tokens.AssertNotCalled(t, "Store", mock.Anything, mock.Anything)
+		mailer.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+
+	t.Run("SkipUnknownEmail", func(t *testing.T) {
+		tokens := new(authmock.MockMemberTokenStorage)
+		repo := new(authmock.MockAuthRepository)
+		mailer := new(authmock.MockMailer)
+		repo.On("GetByEmail", ctx, email).Return(nil, auth.ErrUserNotFound)
+
+		svc := authservice.NewEmailVerificationService(tokens, repo, mailer)
+		require.NoError(t, svc.SendVerification(ctx, email))
+		mailer.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+
+	t.Run("VerifySuccess", func(t *testing.T) {
+		tokens := new(authmock.MockMemberTokenStorage)
+		repo := new(authmock.MockAuthRepository)
+		member := newMember(t)
+		hash := sha256.Sum256([]byte("token"))
+
+		tokens.On("Consume", ctx, hash[:]).Return(&authservice.MemberToken{
+			TokenHash: hash[:],
+			MemberID:  member.ID(),
+			ExpiresAt: time.Now().Add(time.Minute),
+		}, nil)
+		repo.On("Update", ctx, member.ID(), updateFn).
+			Run(func(args mock.Arguments) {
+				fn := args.Get(2).(func(auth.User) (auth.User, error))
+				_, err := fn(member)
+				require.NoError(t, err)
+			}).
+			Return(member, nil)
+
+		svc := authservice.NewEmailVerificationService(tokens, repo, new(authmock.MockMailer))
+		require.False(t, member.EmailVerified())
+		require.NoError(t, svc.VerifyEmail(ctx, "token"))
+		assert.True(t, member.EmailVerified())
+	})
+
+	t.Run("VerifyInvalidToken", func(t *testing.T) {
+		tokens := new(authmock.MockMemberTokenStorage)
+		repo := new(authmock.MockAuthRepository)
+		tokens.On("Consume", ctx, mock.Anything).Return(nil, authservice.ErrInvalidToken)
+
+		svc := authservice.NewEmailVerificationService(tokens, repo, new(authmock.MockMailer))
+		err := svc.VerifyEmail(ctx, "token")
+		require.ErrorIs(t, err, authservice.ErrInvalidToken)
+		repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -1,6 +1,9 @@
 package authservice
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 type AuthServiceError struct {
 	msg string
@@ -14,4 +17,41 @@ var (
 	ErrInvalidCredentials = &AuthServiceError{msg: "invalid credentials"}
 	ErrSessionExpired     = &AuthServiceError{msg: "session expired"}
 	ErrSessionNotFound    = &AuthServiceError{msg: "session not found"}
+
+	ErrInvalidToken          = &AuthServiceError{msg: "invalid or expired token"}
+	ErrPasswordNotResettable = &AuthServiceError{msg: "password of the user can't be reset"}
+	ErrPasswordChanged       = &AuthServiceError{msg: "password was changed"}
+
+	ErrWeakPassword     = &AuthServiceError{msg: "password is too weak"}
+	ErrPasswordTooShort = fmt.Errorf("%w: too short", ErrWeakPassword)
+	ErrPasswordBreached = fmt.Errorf("%w: found in breached passwords", ErrWeakPassword)
+
+	ErrTooManyLoginAttempts = &AuthServiceError{msg: "too many login attempts"}
+	ErrNoLoginAttempts      = &AuthServiceError{msg: "no login attempts"}
+
+	ErrAPITokenNotFound = &AuthServiceError{msg: "api token not found"}
+	ErrSessionRequired  = &AuthServiceError{msg: "action needs a session, not an api token"}
+
+	ErrProfileNotEditable = &AuthServiceError{msg: "profile of the user can't be changed"}
+	ErrNameTaken          = &AuthServiceError{msg: "name is already taken"}
+	ErrEmailTaken         = &AuthServiceError{msg: "email is already taken"}
+
+	ErrInvalidOIDCLogin         = &AuthServiceError{msg: "invalid or expired external login"}
+	ErrExternalIdentityNotFound = &AuthServiceError{msg: "external identity not found"}
+	ErrOIDCEmailRequired        = &AuthServiceError{msg: "identity provider didn't share the email"}
+	ErrIdentityNotLinkable      = &AuthServiceError{msg: "external identity can't be linked to the user"}
 )
+
+// LoginThrottledError is returned while login is blocked after failed attempts.
+// It matches ErrTooManyLoginAttempts with errors.Is.
+type LoginThrottledError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginThrottledError) Error() string {
+	return fmt.Sprintf("auth service error: too many login attempts, retry after %v", e.RetryAfter)
+}
+
+func (e *LoginThrottledError) Is(target error) bool {
+	return target == ErrTooManyLoginAttempts
+}
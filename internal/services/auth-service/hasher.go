@@ -4,3 +4,9 @@ type PasswdHasher interface {
 	Hash(passwd []byte) ([]byte, error)
 	Compare(hashedPasswd, plainPasswd []byte) (bool, error)
 }
+
+// PasswdRehasher is implemented by hashers that can tell a hash made by an outdated
+// algorithm or cost, such hashes are replaced on login when the password is known.
+type PasswdRehasher interface {
+	NeedsRehash(hashedPasswd []byte) bool
+}
@@ -0,0 +1,140 @@
+package authservice
+
+import (
+	"PlantSite/internal/models/auth"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// LoginAttempts counts failed logins under one key, like an identifier or an IP.
+type LoginAttempts struct {
+	Key         string
+	Failures    int
+	LastFailure time.Time
+	ExpiresAt   time.Time
+}
+
+type LoginAttemptStorage interface {
+	// Get returns ErrNoLoginAttempts if there are no failures under the key or they expired.
+	Get(ctx context.Context, key string) (*LoginAttempts, error)
+	// AddFailure counts a failure atomically, expired failures are forgotten first.
+	AddFailure(ctx context.Context, key string, at, expiresAt time.Time) (*LoginAttempts, error)
+	Delete(ctx context.Context, key string) error
+	ClearExpired(ctx context.Context) error
+}
+
+// LoginLimiter guards AuthService.Login against password guessing.
+// Failures are counted per account and per IP, after the free attempts
+// every failure doubles the delay before the next login until the lockout time.
+type LoginLimiter struct {
+	auth     *AuthService
+	attempts LoginAttemptStorage
+	logger   *zap.SugaredLogger
+}
+
+func NewLoginLimiter(auth *AuthService, attempts LoginAttemptStorage, logger *zap.SugaredLogger) *LoginLimiter {
+	if auth == nil {
+		panic("nil auth")
+	}
+	if attempts == nil {
+		panic("nil attempts")
+	}
+	if logger == nil {
+		panic("nil logger")
+	}
+	return &LoginLimiter{
+		auth:     auth,
+		attempts: attempts,
+		logger:   logger,
+	}
+}
+
+type attemptKey struct {
+	key          string
+	freeAttempts int
+}
+
+// loginAttemptKeys keys the failures of an existing account by the member, so that its email
+// and name share the failures, and failures of unknown identifiers by the identifier.
+func loginAttemptKeys(user auth.User, identifier, ip string) []attemptKey {
+	key := "identifier:" + strings.ToLower(strings.TrimSpace(identifier))
+	if user != nil {
+		key = "member:" + user.ID().String()
+	}
+	keys := []attemptKey{{key: key, freeAttempts: LoginIdentifierFreeAttempts}}
+	if ip != "" {
+		keys = append(keys, attemptKey{key: "ip:" + ip, freeAttempts: LoginIPFreeAttempts})
+	}
+	return keys
+}
+
+// loginDelay is how long login is blocked after the last of the failures.
+func loginDelay(failures, freeAttempts int) time.Duration {
+	if failures < freeAttempts {
+		return 0
+	}
+	shift := failures - freeAttempts
+	if shift >= 32 {
+		return LoginLockoutTime
+	}
+	return min(LoginBackoffBase<<shift, LoginLockoutTime)
+}
+
+// Login is AuthService.Login that refuses with LoginThrottledError while
+// the account or the IP are blocked.
+func (l *LoginLimiter) Login(ctx context.Context, identifier, password, ip string, remember bool) (uuid.UUID, error) {
+	user, err := l.auth.lookup(ctx, identifier)
+	if err != nil && !errors.Is(err, auth.ErrUserNotFound) {
+		return uuid.Nil, err
+	}
+	keys := loginAttemptKeys(user, identifier, ip)
+	now := time.Now()
+
+	var retryAfter time.Duration
+	for _, k := range keys {
+		attempts, err := l.attempts.Get(ctx, k.key)
+		if errors.Is(err, ErrNoLoginAttempts) {
+			continue
+		} else if err != nil {
+			return uuid.Nil, err
+		}
+		blockedUntil := attempts.LastFailure.Add(loginDelay(attempts.Failures, k.freeAttempts))
+		retryAfter = max(retryAfter, blockedUntil.Sub(now))
+	}
+	if retryAfter > 0 {
+		return uuid.Nil, &LoginThrottledError{RetryAfter: retryAfter}
+	}
+
+	// Unknown identifiers fail with the error of the lookup
+	var sid uuid.UUID
+	if user != nil {
+		sid, err = l.auth.login(ctx, user, password, remember)
+	}
+	if err == nil {
+		// The IP keeps its failures, one known password must not unblock guessing others
+		if err := l.attempts.Delete(ctx, keys[0].key); err != nil {
+			l.logger.Errorw("Failed to reset login attempts", "key", keys[0].key, "error", err)
+		}
+		return sid, nil
+	}
+	if !errors.Is(err, ErrInvalidCredentials) && !errors.Is(err, auth.ErrUserNotFound) {
+		return uuid.Nil, err
+	}
+
+	for _, k := range keys {
+		attempts, addErr := l.attempts.AddFailure(ctx, k.key, now, now.Add(LoginAttemptWindow))
+		if addErr != nil {
+			l.logger.Errorw("Failed to count login attempt", "key", k.key, "error", addErr)
+			continue
+		}
+		if delay := loginDelay(attempts.Failures, k.freeAttempts); delay >= LoginLockoutTime {
+			l.logger.Warnw("Login locked out", "key", k.key, "failures", attempts.Failures, "until", now.Add(delay))
+		}
+	}
+	return uuid.Nil, err
+}
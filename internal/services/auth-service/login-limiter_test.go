@@ -0,0 +1,150 @@
+package authservice_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	attemptstorage "PlantSite/internal/infra/attempt-storage"
+	"PlantSite/internal/models/auth"
+	authservice "PlantSite/internal/services/auth-service"
+	authmock "PlantSite/internal/services/auth-service/auth-mock"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestLoginLimiter(t *testing.T) {
+	ctx := context.Background()
+	email := "test@example.com"
+	ip := "10.0.0.1"
+
+	prevIdentifier, prevIP := authservice.LoginIdentifierFreeAttempts, authservice.LoginIPFreeAttempts
+	prevBase, prevLockout := authservice.LoginBackoffBase, authservice.LoginLockoutTime
+	t.Cleanup(func() {
+		authservice.LoginIdentifierFreeAttempts, authservice.LoginIPFreeAttempts = prevIdentifier, prevIP
+		authservice.LoginBackoffBase, authservice.LoginLockoutTime = prevBase, prevLockout
+	})
+	authservice.UpdateLoginIdentifierFreeAttempts(2)
+	authservice.UpdateLoginIPFreeAttempts(3)
+	authservice.UpdateLoginBackoffBase(time.Minute)
+	authservice.UpdateLoginLockoutTime(4 * time.Minute)
+
+	member, err := auth.NewMember("test", email, []byte("hash"))
+	require.NoError(t, err)
+
+	newLimiter := func(t *testing.T, correct bool) (*authservice.LoginLimiter, *attemptstorage.MapLoginAttemptStorage, *authmock.MockPasswdHasher, *observer.ObservedLogs) {
+		repo := new(authmock.MockAuthRepository)
+		sessions := new(authmock.MockSessionStorage)
+		hasher := new(authmock.MockPasswdHasher)
+		repo.On("GetByEmail", ctx, email).Return(member, nil)
+		repo.On("GetByEmail", ctx, mock.Anything).Return(nil, auth.ErrUserNotFound)
+		repo.On("GetByName", ctx, member.Name()).Return(member, nil)
+		repo.On("GetByName", ctx, mock.Anything).Return(nil, auth.ErrUserNotFound)
+		hasher.On("Compare", mock.Anything, mock.Anything).Return(correct, nil)
+		sessions.On("Store", ctx, mock.Anything, mock.Anything).Return(nil)
+
+		core, logs := observer.New(zapcore.WarnLevel)
+		attempts := attemptstorage.NewMapLoginAttemptStorage()
+		limiter := authservice.NewLoginLimiter(authservice.NewAuthService(sessions, repo, hasher), attempts, zap.New(core).Sugar())
+		return limiter, attempts, hasher, logs
+	}
+
+	t.Run("BackoffAfterFreeAttempts", func(t *testing.T) {
+		limiter, _, hasher, _ := newLimiter(t, false)
+
+		for range 2 {
+			_, err := limiter.Login(ctx, email, "wrong", ip, false)
+			require.ErrorIs(t, err, authservice.ErrInvalidCredentials)
+		}
+
+		_, err := limiter.Login(ctx, email, "wrong", ip, false)
+		require.ErrorIs(t, err, authservice.ErrTooManyLoginAttempts)
+		var throttled *authservice.LoginThrottledError
+		require.True(t, errors.As(err, &throttled))
+		assert.InDelta(t, time.Minute.Seconds(), throttled.RetryAfter.Seconds(), 1)
+		hasher.AssertNumberOfCalls(t, "Compare", 2)
+	})
+
+	t.Run("DelayDoubles", func(t *testing.T) {
+		limiter, attempts, _, logs := newLimiter(t, false)
+		past := time.Now().Add(-10 * time.Minute)
+		for range 3 {
+			_, err := attempts.AddFailure(ctx, "member:"+member.ID().String(), past, time.Now().Add(time.Hour))
+			require.NoError(t, err)
+		}
+
+		_, err := limiter.Login(ctx, email, "wrong", ip, false)
+		require.ErrorIs(t, err, authservice.ErrInvalidCredentials)
+
+		_, err = limiter.Login(ctx, email, "wrong", ip, false)
+		var throttled *authservice.LoginThrottledError
+		require.True(t, errors.As(err, &throttled))
+		assert.InDelta(t, (4 * time.Minute).Seconds(), throttled.RetryAfter.Seconds(), 1)
+
+		// The fourth failure reaches the lockout time
+		require.Equal(t, 1, logs.FilterMessage("Login locked out").Len())
+	})
+
+	t.Run("EmailAndNameShareFailures", func(t *testing.T) {
+		limiter, _, _, _ := newLimiter(t, false)
+
+		_, err := limiter.Login(ctx, email, "wrong", ip, false)
+		require.ErrorIs(t, err, authservice.ErrInvalidCredentials)
+		_, err = limiter.Login(ctx, member.Name(), "wrong", "10.0.0.2", false)
+		require.ErrorIs(t, err, authservice.ErrInvalidCredentials)
+
+		_, err = limiter.Login(ctx, email, "wrong", "10.0.0.3", false)
+		require.ErrorIs(t, err, authservice.ErrTooManyLoginAttempts)
+	})
+
+	t.Run("IPThrottledAcrossIdentifiers", func(t *testing.T) {
+		limiter, _, _, _ := newLimiter(t, false)
+
+		for i := range 3 {
+			_, err := limiter.Login(ctx, uuid.NewString(), "wrong", ip, false)
+			require.ErrorIs(t, err, auth.ErrUserNotFound, "attempt %d", i)
+		}
+
+		_, err := limiter.Login(ctx, uuid.NewString(), "wrong", ip, false)
+		require.ErrorIs(t, err, authservice.ErrTooManyLoginAttempts)
+
+		_, err = limiter.Login(ctx, uuid.NewString(), "wrong", "10.0.0.2", false)
+		require.ErrorIs(t, err, auth.ErrUserNotFound)
+	})
+
+	t.Run("SuccessResetsIdentifier", func(t *testing.T) {
+		limiter, attempts, _, _ := newLimiter(t, true)
+		now := time.Now()
+		_, err := attempts.AddFailure(ctx, "member:"+member.ID().String(), now, now.Add(time.Hour))
+		require.NoError(t, err)
+		_, err = attempts.AddFailure(ctx, "ip:"+ip, now, now.Add(time.Hour))
+		require.NoError(t, err)
+
+		sid, err := limiter.Login(ctx, email, "password", ip, false)
+		require.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, sid)
+
+		_, err = attempts.Get(ctx, "member:"+member.ID().String())
+		require.ErrorIs(t, err, authservice.ErrNoLoginAttempts)
+		_, err = attempts.Get(ctx, "ip:"+ip)
+		require.NoError(t, err)
+	})
+
+	t.Run("IdentifierNormalized", func(t *testing.T) {
+		limiter, attempts, _, _ := newLimiter(t, false)
+
+		_, err := limiter.Login(ctx, " Test@Example.com ", "wrong", ip, false)
+		require.Error(t, err)
+
+		got, err := attempts.Get(ctx, "identifier:"+email)
+		require.NoError(t, err)
+		assert.Equal(t, 1, got.Failures)
+	})
+}
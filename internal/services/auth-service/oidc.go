@@ -0,0 +1,225 @@
+package authservice
+
+import (
+	"PlantSite/internal/models/auth"
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// oidcNameAttempts is how many numbered variants of the provider username are tried for a new member.
+const oidcNameAttempts = 10
+
+// OIDCIdentity is the account of the identity provider taken from a verified ID token.
+type OIDCIdentity struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Username      string
+	Nonce         string
+}
+
+// OIDCProvider is the OpenID Connect identity provider members may sign in with.
+type OIDCProvider interface {
+	// AuthCodeURL is the login page of the provider, PKCE challenge is of the S256 method.
+	AuthCodeURL(state, nonce, codeChallenge string) string
+	// Exchange redeems the authorization code and returns the identity of the verified ID token.
+	Exchange(ctx context.Context, code, codeVerifier string) (*OIDCIdentity, error)
+}
+
+// ExternalIdentity links an account of the identity provider to a member.
+type ExternalIdentity struct {
+	Issuer    string
+	Subject   string
+	MemberID  uuid.UUID
+	CreatedAt time.Time
+}
+
+type ExternalIdentityStorage interface {
+	Store(ctx context.Context, identity *ExternalIdentity) error
+	// Get returns ErrExternalIdentityNotFound if the account isn't linked to a member.
+	Get(ctx context.Context, issuer, subject string) (*ExternalIdentity, error)
+}
+
+// OIDCLogin is kept by the browser from the start of the login until the provider redirects back.
+type OIDCLogin struct {
+	State        string
+	Nonce        string
+	CodeVerifier string
+}
+
+// OIDCService signs members in with the identity provider using the authorization code flow with PKCE.
+// Unknown accounts are linked to the member with the same verified email or get a new member.
+type OIDCService struct {
+	provider   OIDCProvider
+	identities ExternalIdentityStorage
+	auth       *AuthService
+}
+
+func NewOIDCService(provider OIDCProvider, identities ExternalIdentityStorage, auth *AuthService) *OIDCService {
+	if provider == nil {
+		panic("nil provider")
+	}
+	if identities == nil {
+		panic("nil identities")
+	}
+	if auth == nil {
+		panic("nil auth")
+	}
+	return &OIDCService{
+		provider:   provider,
+		identities: identities,
+		auth:       auth,
+	}
+}
+
+// Begin starts a login and returns it together with the provider page to send the user to.
+func (s *OIDCService) Begin() (*OIDCLogin, string, error) {
+	login := &OIDCLogin{}
+	for _, value := range []*string{&login.State, &login.Nonce, &login.CodeVerifier} {
+		token, err := newMemberToken()
+		if err != nil {
+			return nil, "", err
+		}
+		*value = token
+	}
+	return login, s.provider.AuthCodeURL(login.State, login.Nonce, codeChallenge(login.CodeVerifier)), nil
+}
+
+func codeChallenge(verifier string) string {
+	hash := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// Complete finishes the login started with Begin and creates the same session as Login.
+func (s *OIDCService) Complete(ctx context.Context, login *OIDCLogin, state, code string) (uuid.UUID, error) {
+	if login == nil || subtle.ConstantTimeCompare([]byte(login.State), []byte(state)) != 1 {
+		return uuid.Nil, ErrInvalidOIDCLogin
+	}
+	identity, err := s.provider.Exchange(ctx, code, login.CodeVerifier)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(login.Nonce), []byte(identity.Nonce)) != 1 {
+		return uuid.Nil, ErrInvalidOIDCLogin
+	}
+
+	user, err := s.member(ctx, identity)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return s.auth.startSession(ctx, user, false)
+}
+
+// member returns the member of the account, linking the account on the first login.
+func (s *OIDCService) member(ctx context.Context, identity *OIDCIdentity) (auth.User, error) {
+	linked, err := s.identities.Get(ctx, identity.Issuer, identity.Subject)
+	if err == nil {
+		user, err := s.auth.repository.Get(ctx, linked.MemberID)
+		if err != nil {
+			return nil, err
+		}
+		// The member could have been promoted after linking, admins sign in with their password only
+		if auth.IsAdmin(user) {
+			return nil, ErrIdentityNotLinkable
+		}
+		return user, nil
+	} else if !errors.Is(err, ErrExternalIdentityNotFound) {
+		return nil, err
+	}
+
+	user, err := s.auth.repository.GetByEmail(ctx, identity.Email)
+	switch {
+	case errors.Is(err, auth.ErrUserNotFound):
+		user, err = s.provision(ctx, identity)
+		if err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	// Both sides must vouch for the email, otherwise anyone could take over the member with it
+	// or the member could have registered the email of someone else before the owner signs in
+	case !identity.EmailVerified, !auth.IsEmailVerified(user):
+		return nil, ErrEmailTaken
+	case auth.IsAdmin(user):
+		return nil, ErrIdentityNotLinkable
+	}
+
+	err = s.identities.Store(ctx, &ExternalIdentity{
+		Issuer:    identity.Issuer,
+		Subject:   identity.Subject,
+		MemberID:  user.ID(),
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// provision registers a member for the account. The member gets a random password
+// and may set a real one with the password reset.
+func (s *OIDCService) provision(ctx context.Context, identity *OIDCIdentity) (auth.User, error) {
+	if identity.Email == "" {
+		return nil, ErrOIDCEmailRequired
+	}
+	name, err := s.availableName(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+	password, err := newMemberToken()
+	if err != nil {
+		return nil, err
+	}
+	hashedPasswd, err := s.auth.hasher.Hash([]byte(password))
+	if err != nil {
+		return nil, err
+	}
+	member, err := auth.NewMember(name, identity.Email, hashedPasswd)
+	if err != nil {
+		return nil, err
+	}
+	if identity.EmailVerified {
+		member.MarkEmailVerified()
+	}
+	return s.auth.repository.Create(ctx, member)
+}
+
+// availableName picks a free member name from the provider username or the email.
+func (s *OIDCService) availableName(ctx context.Context, identity *OIDCIdentity) (string, error) {
+	base := identity.Username
+	if base == "" {
+		base, _, _ = strings.Cut(identity.Email, "@")
+	}
+	for i := 1; i <= oidcNameAttempts; i++ {
+		suffix := ""
+		if i > 1 {
+			suffix = fmt.Sprintf("-%d", i)
+		}
+		name := truncateName(base, auth.MaximumNameLength-len(suffix)) + suffix
+		_, err := s.auth.repository.GetByName(ctx, name)
+		if errors.Is(err, auth.ErrUserNotFound) {
+			return name, nil
+		} else if err != nil {
+			return "", err
+		}
+	}
+	return "", ErrNameTaken
+}
+
+func truncateName(name string, length int) string {
+	for len(name) > length {
+		_, size := utf8.DecodeLastRuneInString(name)
+		name = name[:len(name)-size]
+	}
+	return name
+}
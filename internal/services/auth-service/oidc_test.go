@@ -0,0 +1,209 @@
+package authservice_test
+
+import (
+	"context"
+	"testing"
+
+	identitystorage "PlantSite/internal/infra/identity-storage"
+	"PlantSite/internal/infra/oidc"
+	"PlantSite/internal/infra/oidc/oidctest"
+	"PlantSite/internal/models/auth"
+	authservice "PlantSite/internal/services/auth-service"
+	authmock "PlantSite/internal/services/auth-service/auth-mock"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOIDCLogin(t *testing.T) {
+	ctx := context.Background()
+	issuer := oidctest.NewIssuer("plant-site", "secret")
+	defer issuer.Close()
+
+	provider, err := oidc.NewProvider(ctx, oidc.Config{
+		Issuer:       issuer.URL(),
+		ClientID:     "plant-site",
+		ClientSecret: "secret",
+		RedirectURL:  "http://localhost/api/auth/oidc/callback",
+	}, issuer.Client())
+	require.NoError(t, err)
+
+	account := oidctest.Account{
+		Subject:       "user-1",
+		Email:         "user@example.com",
+		EmailVerified: true,
+		Username:      "user",
+	}
+
+	type env struct {
+		svc        *authservice.OIDCService
+		repo       *authmock.MockAuthRepository
+		sessions   *authmock.MockSessionStorage
+		identities *identitystorage.MapExternalIdentityStorage
+	}
+
+	newEnv := func(t *testing.T) *env {
+		e := &env{
+			repo:       new(authmock.MockAuthRepository),
+			sessions:   new(authmock.MockSessionStorage),
+			identities: identitystorage.NewMapExternalIdentityStorage(),
+		}
+		hasher := new(authmock.MockPasswdHasher)
+		hasher.On("Hash", mock.Anything).Return([]byte("hash"), nil)
+		e.sessions.On("Store", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		asvc := authservice.NewAuthService(e.sessions, e.repo, hasher)
+		e.svc = authservice.NewOIDCService(provider, e.identities, asvc)
+		return e
+	}
+
+	// signIn runs the whole flow as the browser would
+	signIn := func(t *testing.T, e *env, account oidctest.Account) (uuid.UUID, error) {
+		login, authURL, err := e.svc.Begin()
+		require.NoError(t, err)
+		state, code, err := issuer.Authorize(authURL, account)
+		require.NoError(t, err)
+		return e.svc.Complete(ctx, login, state, code)
+	}
+
+	t.Run("ProvisionOnFirstLogin", func(t *testing.T) {
+		e := newEnv(t)
+		e.repo.On("GetByEmail", mock.Anything, "user@example.com").Return(nil, auth.ErrUserNotFound)
+		e.repo.On("GetByName", mock.Anything, "user").Return(&auth.Member{}, nil)
+		e.repo.On("GetByName", mock.Anything, "user-2").Return(nil, auth.ErrUserNotFound)
+		var created *auth.Member
+		create := e.repo.On("Create", mock.Anything, mock.AnythingOfType("*auth.Member"))
+		create.Run(func(args mock.Arguments) {
+			created = args.Get(1).(*auth.Member)
+			create.ReturnArguments = mock.Arguments{created, nil}
+		})
+
+		sid, err := signIn(t, e, account)
+		require.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, sid)
+
+		require.NotNil(t, created)
+		assert.Equal(t, "user-2", created.Name())
+		assert.Equal(t, "user@example.com", created.Email())
+		assert.True(t, created.EmailVerified())
+		e.sessions.AssertCalled(t, "Store", mock.Anything, sid, mock.MatchedBy(func(s *authservice.Session) bool {
+			return s.MemberID == created.ID() && !s.Remember
+		}))
+
+		identity, err := e.identities.Get(ctx, issuer.URL(), "user-1")
+		require.NoError(t, err)
+		assert.Equal(t, created.ID(), identity.MemberID)
+	})
+
+	t.Run("LinkedIdentity", func(t *testing.T) {
+		e := newEnv(t)
+		member, err := auth.NewMember("linked", "old@example.com", []byte("hash"))
+		require.NoError(t, err)
+		require.NoError(t, e.identities.Store(ctx, &authservice.ExternalIdentity{
+			Issuer:   issuer.URL(),
+			Subject:  "user-1",
+			MemberID: member.ID(),
+		}))
+		e.repo.On("Get", mock.Anything, member.ID()).Return(member, nil)
+
+		_, err = signIn(t, e, account)
+		require.NoError(t, err)
+		e.repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("LinkByVerifiedEmail", func(t *testing.T) {
+		e := newEnv(t)
+		member, err := auth.NewMember("existing", "user@example.com", []byte("hash"))
+		require.NoError(t, err)
+		member.MarkEmailVerified()
+		e.repo.On("GetByEmail", mock.Anything, "user@example.com").Return(member, nil)
+
+		_, err = signIn(t, e, account)
+		require.NoError(t, err)
+		identity, err := e.identities.Get(ctx, issuer.URL(), "user-1")
+		require.NoError(t, err)
+		assert.Equal(t, member.ID(), identity.MemberID)
+	})
+
+	t.Run("UnverifiedEmailTaken", func(t *testing.T) {
+		e := newEnv(t)
+		member, err := auth.NewMember("existing", "user@example.com", []byte("hash"))
+		require.NoError(t, err)
+		e.repo.On("GetByEmail", mock.Anything, "user@example.com").Return(member, nil)
+
+		unverified := account
+		unverified.EmailVerified = false
+		_, err = signIn(t, e, unverified)
+		require.ErrorIs(t, err, authservice.ErrEmailTaken)
+		e.sessions.AssertNotCalled(t, "Store", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("UnverifiedMemberNotLinked", func(t *testing.T) {
+		e := newEnv(t)
+		member, err := auth.NewMember("existing", "user@example.com", []byte("hash"))
+		require.NoError(t, err)
+		e.repo.On("GetByEmail", mock.Anything, "user@example.com").Return(member, nil)
+
+		_, err = signIn(t, e, account)
+		require.ErrorIs(t, err, authservice.ErrEmailTaken)
+		_, err = e.identities.Get(ctx, issuer.URL(), "user-1")
+		require.ErrorIs(t, err, authservice.ErrExternalIdentityNotFound)
+		e.sessions.AssertNotCalled(t, "Store", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("AdminNotLinked", func(t *testing.T) {
+		e := newEnv(t)
+		admin, err := auth.NewAdmin("admin", []byte("hash"))
+		require.NoError(t, err)
+		e.repo.On("GetByEmail", mock.Anything, "user@example.com").Return(admin, nil)
+
+		_, err = signIn(t, e, account)
+		require.ErrorIs(t, err, authservice.ErrIdentityNotLinkable)
+	})
+
+	t.Run("LinkedPromotedToAdmin", func(t *testing.T) {
+		e := newEnv(t)
+		admin, err := auth.NewAdmin("admin", []byte("hash"))
+		require.NoError(t, err)
+		require.NoError(t, e.identities.Store(ctx, &authservice.ExternalIdentity{
+			Issuer:   issuer.URL(),
+			Subject:  "user-1",
+			MemberID: admin.ID(),
+		}))
+		e.repo.On("Get", mock.Anything, admin.ID()).Return(admin, nil)
+
+		_, err = signIn(t, e, account)
+		require.ErrorIs(t, err, authservice.ErrIdentityNotLinkable)
+		e.sessions.AssertNotCalled(t, "Store", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("StateMismatch", func(t *testing.T) {
+		e := newEnv(t)
+		login, authURL, err := e.svc.Begin()
+		require.NoError(t, err)
+		_, code, err := issuer.Authorize(authURL, account)
+		require.NoError(t, err)
+
+		_, err = e.svc.Complete(ctx, login, "forged", code)
+		require.ErrorIs(t, err, authservice.ErrInvalidOIDCLogin)
+		_, err = e.svc.Complete(ctx, nil, "", code)
+		require.ErrorIs(t, err, authservice.ErrInvalidOIDCLogin)
+	})
+
+	t.Run("NonceMismatch", func(t *testing.T) {
+		e := newEnv(t)
+		login, authURL, err := e.svc.Begin()
+		require.NoError(t, err)
+		state, code, err := issuer.Authorize(authURL, account)
+		require.NoError(t, err)
+
+		// The code was issued for another login of the browser
+		other, _, err := e.svc.Begin()
+		require.NoError(t, err)
+		other.State = state
+		other.CodeVerifier = login.CodeVerifier
+		_, err = e.svc.Complete(ctx, other, state, code)
+		require.ErrorIs(t, err, authservice.ErrInvalidOIDCLogin)
+	})
+}
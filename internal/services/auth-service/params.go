@@ -1,18 +1,57 @@
 package authservice
 
 import (
+	"PlantSite/internal/models/auth"
 	"time"
 )
 
 var (
+	// SessionExpireTime is the idle timeout of a session, activity renews it.
 	SessionExpireTime = time.Hour
+	// SessionMaxLifetime limits session renewal, the session expires that long after login regardless of activity.
+	SessionMaxLifetime = 24 * time.Hour
+	// RememberSessionExpireTime is the idle timeout of a "remember me" session.
+	RememberSessionExpireTime = 14 * 24 * time.Hour
+	// RememberSessionMaxLifetime is the absolute lifetime of a "remember me" session.
+	RememberSessionMaxLifetime = 90 * 24 * time.Hour
+	// SessionLastSeenInterval is how often the last activity of a session is recorded.
+	SessionLastSeenInterval = 5 * time.Minute
+
+	// PasswordResetTokenExpireTime is how long an emailed password reset link stays valid.
+	PasswordResetTokenExpireTime = time.Hour
+	// PasswordResetURL is the page the reset token is sent to as the token query parameter.
+	PasswordResetURL = "/view/password/reset"
+
+	// EmailVerificationTokenExpireTime is how long an emailed verification link stays valid.
+	EmailVerificationTokenExpireTime = 24 * time.Hour
+	// EmailVerificationURL is the page the verification token is sent to as the token query parameter.
+	EmailVerificationURL = "/view/email/verify"
+
+	// LoginIdentifierFreeAttempts is how many failed logins to one account, or with one unknown identifier, go without a delay.
+	LoginIdentifierFreeAttempts = 5
+	// LoginIPFreeAttempts is how many failed logins from one IP go without a delay.
+	LoginIPFreeAttempts = 20
+	// LoginBackoffBase is the delay after the first throttled failure, it doubles with every next failure.
+	LoginBackoffBase = time.Second
+	// LoginLockoutTime caps the delay, reaching it locks the login out.
+	LoginLockoutTime = 15 * time.Minute
+	// LoginAttemptWindow is how long failed logins are remembered after the last one.
+	LoginAttemptWindow = time.Hour
+
+	// Policy grants permissions by roles, the roles are loaded from the database on start.
+	Policy = auth.DefaultPolicy()
+	// Passwords is the policy new passwords are checked against.
+	Passwords = DefaultPasswordPolicy()
 )
 
 type authContextKey int
 
 const (
-	AuthContextKey    authContextKey = iota
-	sessionContextKey authContextKey = iota
+	AuthContextKey       authContextKey = iota
+	sessionContextKey    authContextKey = iota
+	tokenScopeContextKey authContextKey = iota
+	clientContextKey     authContextKey = iota
+	userContextKey       authContextKey = iota
 )
 
 func UpdateSessionExpireTime(t time.Duration) {
@@ -21,3 +60,108 @@ func UpdateSessionExpireTime(t time.Duration) {
 	}
 	SessionExpireTime = t
 }
+
+func UpdateSessionMaxLifetime(t time.Duration) {
+	if t <= 0 {
+		panic("session max lifetime must be greater than 0")
+	}
+	SessionMaxLifetime = t
+}
+
+func UpdateRememberSessionExpireTime(t time.Duration) {
+	if t <= 0 {
+		panic("remember session expire time must be greater than 0")
+	}
+	RememberSessionExpireTime = t
+}
+
+func UpdateRememberSessionMaxLifetime(t time.Duration) {
+	if t <= 0 {
+		panic("remember session max lifetime must be greater than 0")
+	}
+	RememberSessionMaxLifetime = t
+}
+
+func UpdateSessionLastSeenInterval(t time.Duration) {
+	if t <= 0 {
+		panic("session last seen interval must be greater than 0")
+	}
+	SessionLastSeenInterval = t
+}
+
+func UpdatePasswordResetTokenExpireTime(t time.Duration) {
+	if t <= 0 {
+		panic("password reset token expire time must be greater than 0")
+	}
+	PasswordResetTokenExpireTime = t
+}
+
+func UpdatePasswordResetURL(u string) {
+	if u == "" {
+		panic("password reset url must not be empty")
+	}
+	PasswordResetURL = u
+}
+
+func UpdateEmailVerificationTokenExpireTime(t time.Duration) {
+	if t <= 0 {
+		panic("email verification token expire time must be greater than 0")
+	}
+	EmailVerificationTokenExpireTime = t
+}
+
+func UpdateEmailVerificationURL(u string) {
+	if u == "" {
+		panic("email verification url must not be empty")
+	}
+	EmailVerificationURL = u
+}
+
+func UpdateLoginIdentifierFreeAttempts(n int) {
+	if n <= 0 {
+		panic("login identifier free attempts must be greater than 0")
+	}
+	LoginIdentifierFreeAttempts = n
+}
+
+func UpdateLoginIPFreeAttempts(n int) {
+	if n <= 0 {
+		panic("login ip free attempts must be greater than 0")
+	}
+	LoginIPFreeAttempts = n
+}
+
+func UpdateLoginBackoffBase(t time.Duration) {
+	if t <= 0 {
+		panic("login backoff base must be greater than 0")
+	}
+	LoginBackoffBase = t
+}
+
+func UpdateLoginLockoutTime(t time.Duration) {
+	if t <= 0 {
+		panic("login lockout time must be greater than 0")
+	}
+	LoginLockoutTime = t
+}
+
+func UpdateLoginAttemptWindow(t time.Duration) {
+	if t <= 0 {
+		panic("login attempt window must be greater than 0")
+	}
+	LoginAttemptWindow = t
+}
+
+func UpdatePolicy(p *auth.Policy) {
+	if p == nil {
+		panic("nil policy")
+	}
+	Policy = p
+}
+
+func UpdatePasswordPolicy(p *PasswordPolicy) {
+	if p == nil {
+		panic("nil password policy")
+	}
+	Passwords = p
+}
@@ -0,0 +1,64 @@
+package authservice
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// PasswordPolicy is checked for every new password, existing passwords still work.
+type PasswordPolicy struct {
+	minLength int
+	denylist  map[string]struct{}
+}
+
+// NewPasswordPolicy makes a policy of passwords at least minLength characters long
+// that are not in the denylist, the denylist is matched case-insensitively.
+func NewPasswordPolicy(minLength int, denylist []string) *PasswordPolicy {
+	if minLength < 1 {
+		panic("password min length must be greater than 0")
+	}
+	policy := &PasswordPolicy{
+		minLength: minLength,
+		denylist:  make(map[string]struct{}, len(denylist)),
+	}
+	for _, passwd := range denylist {
+		policy.denylist[strings.ToLower(passwd)] = struct{}{}
+	}
+	return policy
+}
+
+func DefaultPasswordPolicy() *PasswordPolicy {
+	return NewPasswordPolicy(8, nil)
+}
+
+// Check returns an error matching ErrWeakPassword if the password breaks the policy.
+func (p *PasswordPolicy) Check(password string) error {
+	if utf8.RuneCountInString(password) < p.minLength {
+		return fmt.Errorf("%w, at least %d characters required", ErrPasswordTooShort, p.minLength)
+	}
+	if _, ok := p.denylist[strings.ToLower(password)]; ok {
+		return ErrPasswordBreached
+	}
+	return nil
+}
+
+// ReadPasswordDenylist reads a denylist of one password per line, like the published breached password lists.
+// Empty lines and lines starting with # are skipped.
+func ReadPasswordDenylist(r io.Reader) ([]string, error) {
+	denylist := make([]string, 0)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		denylist = append(denylist, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("password denylist read failed %w", err)
+	}
+	return denylist, nil
+}
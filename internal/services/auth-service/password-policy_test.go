@@ -0,0 +1,44 @@
+package authservice_test
+
+import (
+	"strings"
+	"testing"
+
+	authservice "PlantSite/internal/services/auth-service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordPolicy(t *testing.T) {
+	denylist, err := authservice.ReadPasswordDenylist(strings.NewReader("# top passwords\npassword1\r\n\nQwertyuiop\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"password1", "Qwertyuiop"}, denylist)
+
+	policy := authservice.NewPasswordPolicy(8, denylist)
+
+	t.Run("Strong", func(t *testing.T) {
+		assert.NoError(t, policy.Check("correct horse battery"))
+	})
+
+	t.Run("TooShort", func(t *testing.T) {
+		err := policy.Check("short")
+		require.ErrorIs(t, err, authservice.ErrPasswordTooShort)
+		require.ErrorIs(t, err, authservice.ErrWeakPassword)
+	})
+
+	t.Run("LengthInCharacters", func(t *testing.T) {
+		// 8 characters, 16 bytes
+		assert.NoError(t, policy.Check("пароль12"))
+		assert.ErrorIs(t, policy.Check("пароль1"), authservice.ErrPasswordTooShort)
+	})
+
+	t.Run("Breached", func(t *testing.T) {
+		assert.ErrorIs(t, policy.Check("password1"), authservice.ErrPasswordBreached)
+		assert.ErrorIs(t, policy.Check("QWERTYUIOP"), authservice.ErrPasswordBreached)
+	})
+
+	t.Run("InvalidMinLength", func(t *testing.T) {
+		assert.Panics(t, func() { authservice.NewPasswordPolicy(0, nil) })
+	})
+}
@@ -0,0 +1,99 @@
+package authservice
+
+import (
+	"PlantSite/internal/models/auth"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type PasswordResetService struct {
+	tokens     MemberTokenStorage
+	sessions   SessionStorage
+	repository auth.AuthRepository
+	hasher     PasswdHasher
+	mailer     Mailer
+}
+
+func NewPasswordResetService(tokens MemberTokenStorage, sessions SessionStorage, repository auth.AuthRepository, hasher PasswdHasher, mailer Mailer) *PasswordResetService {
+	if tokens == nil {
+		panic("nil tokens")
+	}
+	if sessions == nil {
+		panic("nil sessions")
+	}
+	if repository == nil {
+		panic("nil repository")
+	}
+	if hasher == nil {
+		panic("nil hasher")
+	}
+	if mailer == nil {
+		panic("nil mailer")
+	}
+	return &PasswordResetService{
+		tokens:     tokens,
+		sessions:   sessions,
+		repository: repository,
+		hasher:     hasher,
+		mailer:     mailer,
+	}
+}
+
+// RequestPasswordReset mails a reset link to the member with the email.
+// Unknown email isn't an error, so that the endpoint can't be used to find out registered emails.
+func (s *PasswordResetService) RequestPasswordReset(ctx context.Context, email string) error {
+	user, err := s.repository.GetByEmail(ctx, email)
+	if err != nil {
+		return nil
+	}
+
+	token, err := issueMemberToken(ctx, s.tokens, user.ID(), PasswordResetTokenExpireTime)
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.Send(ctx, Mail{
+		To:      email,
+		Subject: "Password reset",
+		Body: fmt.Sprintf(
+			"Hello, %s!\n\nFollow the link to set a new password: %s\n\nThe link expires in %v. If you didn't ask for a password reset, ignore this mail.\n",
+			user.Username(), tokenLink(PasswordResetURL, token), PasswordResetTokenExpireTime,
+		),
+	})
+}
+
+// ResetPassword sets a new password of the member the token was issued for.
+// The token can't be used again, even if the reset fails, but a weak password doesn't use it up.
+// All sessions of the member are ended, whoever knew the old password is signed out.
+func (s *PasswordResetService) ResetPassword(ctx context.Context, token, password string) error {
+	if err := Passwords.Check(password); err != nil {
+		return err
+	}
+	resetToken, err := consumeMemberToken(ctx, s.tokens, token)
+	if err != nil {
+		return err
+	}
+
+	hashedPasswd, err := s.hasher.Hash([]byte(password))
+	if err != nil {
+		return err
+	}
+
+	_, err = s.repository.Update(ctx, resetToken.MemberID, func(user auth.User) (auth.User, error) {
+		member, ok := user.(interface{ UpdateHashedPassword([]byte) error })
+		if !ok {
+			return nil, ErrPasswordNotResettable
+		}
+		if err := member.UpdateHashedPassword(hashedPasswd); err != nil {
+			return nil, err
+		}
+		return user, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.sessions.DeleteByMember(ctx, resetToken.MemberID, uuid.Nil)
+}
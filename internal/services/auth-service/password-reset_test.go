@@ -0,0 +1,137 @@
+package authservice_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"PlantSite/internal/models/auth"
+	authservice "PlantSite/internal/services/auth-service"
+	authmock "PlantSite/internal/services/auth-service/auth-mock"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var resetLinkRegexp = regexp.MustCompile(`\S+\?token=\S+`)
+
+func TestPasswordReset(t *testing.T) {
+	ctx := context.Background()
+	email := "test@example.com"
+	tokenType := mock.AnythingOfType("*authservice.MemberToken")
+	updateFn := mock.AnythingOfType("func(auth.User) (auth.User, error)")
+
+	newMember := func(t *testing.T) *auth.Member {
+		member, err := auth.NewMember("test", email, []byte("oldhash"))
+		require.NoError(t, err)
+		return member
+	}
+
+	t.Run("RequestSendsLink", func(t *testing.T) {
+		tokens := new(authmock.MockMemberTokenStorage)
+		repo := new(authmock.MockAuthRepository)
+		mailer := new(authmock.MockMailer)
+		member := newMember(t)
+
+		var stored *authservice.MemberToken
+		var sent authservice.Mail
+		repo.On("GetByEmail", ctx, email).Return(member, nil)
+		tokens.On("Store", ctx, tokenType).
+			Run(func(args mock.Arguments) { stored = args.Get(1).(*authservice.MemberToken) }).
+			Return(nil)
+		mailer.On("Send", ctx, mock.AnythingOfType("authservice.Mail")).
+			Run(func(args mock.Arguments) { sent = args.Get(1).(authservice.Mail) }).
+			Return(nil)
+
+		svc := authservice.NewPasswordResetService(tokens, new(authmock.MockSessionStorage), repo, new(authmock.MockPasswdHasher), mailer)
+		require.NoError(t, svc.RequestPasswordReset(ctx, email))
+
+		require.NotNil(t, stored)
+		assert.Equal(t, member.ID(), stored.MemberID)
+		assert.WithinDuration(t, time.Now().Add(authservice.PasswordResetTokenExpireTime), stored.ExpiresAt, time.Second)
+		assert.Equal(t, email, sent.To)
+
+		// The mail carries the token itself, only its hash is stored
+		link, err := url.Parse(resetLinkRegexp.FindString(sent.Body))
+		require.NoError(t, err)
+		token := link.Query().Get("token")
+		require.NotEmpty(t, token)
+		hash := sha256.Sum256([]byte(token))
+		assert.Equal(t, hash[:], stored.TokenHash)
+	})
+
+	t.Run("RequestUnknownEmail", func(t *testing.T) {
+		tokens := new(authmock.MockMemberTokenStorage)
+		repo := new(authmock.MockAuthRepository)
+		mailer := new(authmock.MockMailer)
+		repo.On("GetByEmail", ctx, email).Return(nil, auth.ErrUserNotFound)
+
+		svc := authservice.NewPasswordResetService(tokens, new(authmock.MockSessionStorage), repo, new(authmock.MockPasswdHasher), mailer)
+		require.NoError(t, svc.RequestPasswordReset(ctx, email))
+		tokens.AssertNotCalled(t, "Store", mock.Anything, mock.Anything)
+		mailer.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ResetSuccess", func(t *testing.T) {
+		tokens := new(authmock.MockMemberTokenStorage)
+		repo := new(authmock.MockAuthRepository)
+		hasher := new(authmock.MockPasswdHasher)
+		member := newMember(t)
+		hash := sha256.Sum256([]byte("token"))
+
+		tokens.On("Consume", ctx, hash[:]).Return(&authservice.MemberToken{
+			TokenHash: hash[:],
+			MemberID:  member.ID(),
+			ExpiresAt: time.Now().Add(time.Minute),
+		}, nil)
+		hasher.On("Hash", []byte("newpassword")).Return([]byte("newhash"), nil)
+		var updated auth.User
+		repo.On("Update", ctx, member.ID(), updateFn).
+			Run(func(args mock.Arguments) {
+				fn := args.Get(2).(func(auth.User) (auth.User, error))
+				var err error
+				updated, err = fn(member)
+				require.NoError(t, err)
+			}).
+			Return(member, nil)
+
+		sessions := new(authmock.MockSessionStorage)
+		sessions.On("DeleteByMember", ctx, member.ID(), uuid.Nil).Return(nil)
+
+		svc := authservice.NewPasswordResetService(tokens, sessions, repo, hasher, new(authmock.MockMailer))
+		require.NoError(t, svc.ResetPassword(ctx, "token", "newpassword"))
+		require.NotNil(t, updated)
+		assert.Equal(t, []byte("newhash"), member.HashedPassword())
+		sessions.AssertCalled(t, "DeleteByMember", ctx, member.ID(), uuid.Nil)
+	})
+
+	t.Run("ResetUnknownToken", func(t *testing.T) {
+		tokens := new(authmock.MockMemberTokenStorage)
+		repo := new(authmock.MockAuthRepository)
+		tokens.On("Consume", ctx, mock.Anything).Return(nil, authservice.ErrInvalidToken)
+
+		svc := authservice.NewPasswordResetService(tokens, new(authmock.MockSessionStorage), repo, new(authmock.MockPasswdHasher), new(authmock.MockMailer))
+		err := svc.ResetPassword(ctx, "token", "newpassword")
+		require.ErrorIs(t, err, authservice.ErrInvalidToken)
+		repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ResetExpiredToken", func(t *testing.T) {
+		tokens := new(authmock.MockMemberTokenStorage)
+		repo := new(authmock.MockAuthRepository)
+		tokens.On("Consume", ctx, mock.Anything).Return(&authservice.MemberToken{
+			MemberID:  uuid.New(),
+			ExpiresAt: time.Now().Add(-time.Minute),
+		}, nil)
+
+		svc := authservice.NewPasswordResetService(tokens, new(authmock.MockSessionStorage), repo, new(authmock.MockPasswdHasher), new(authmock.MockMailer))
+		err := svc.ResetPassword(ctx, "token", "newpassword")
+		require.ErrorIs(t, err, authservice.ErrInvalidToken)
+		repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,114 @@
+package authservice
+
+import (
+	"PlantSite/internal/models/auth"
+	"context"
+	"errors"
+)
+
+// profileUser returns the user of the context whose profile can be changed.
+// Admins are managed with the admin command, and tokens can't change the profile.
+func (s *AuthService) profileUser(ctx context.Context) (auth.User, error) {
+	user := s.UserFromContext(ctx)
+	if !user.IsAuthenticated() {
+		return nil, auth.ErrNotAuthorized
+	}
+	if auth.IsScoped(user) {
+		return nil, ErrSessionRequired
+	}
+	if auth.IsAdmin(user) {
+		return nil, ErrProfileNotEditable
+	}
+	return user, nil
+}
+
+func (s *AuthService) ChangeName(ctx context.Context, name string) error {
+	user, err := s.profileUser(ctx)
+	if err != nil {
+		return err
+	}
+	if other, err := s.repository.GetByName(ctx, name); err == nil && other.ID() != user.ID() {
+		return ErrNameTaken
+	} else if err != nil && !errors.Is(err, auth.ErrUserNotFound) {
+		return err
+	}
+
+	_, err = s.repository.Update(ctx, user.ID(), func(user auth.User) (auth.User, error) {
+		member, ok := user.(interface{ UpdateName(string) error })
+		if !ok {
+			return nil, ErrProfileNotEditable
+		}
+		return user, member.UpdateName(name)
+	})
+	return err
+}
+
+// ChangeEmail sets a new email of the user if the current password is right,
+// the new email has to be verified again.
+func (s *AuthService) ChangeEmail(ctx context.Context, currentPassword, email string) error {
+	user, err := s.profileUser(ctx)
+	if err != nil {
+		return err
+	}
+	if !user.Auth([]byte(currentPassword), s.hasher.Compare) {
+		return ErrInvalidCredentials
+	}
+	if other, err := s.repository.GetByEmail(ctx, email); err == nil && other.ID() != user.ID() {
+		return ErrEmailTaken
+	} else if err != nil && !errors.Is(err, auth.ErrUserNotFound) {
+		return err
+	}
+
+	_, err = s.repository.Update(ctx, user.ID(), func(user auth.User) (auth.User, error) {
+		member, ok := user.(interface {
+			Email() string
+			UpdateEmail(string) error
+			MarkEmailUnverified()
+		})
+		if !ok {
+			return nil, ErrProfileNotEditable
+		}
+		if member.Email() == email {
+			return user, nil
+		}
+		if err := member.UpdateEmail(email); err != nil {
+			return nil, err
+		}
+		member.MarkEmailUnverified()
+		return user, nil
+	})
+	return err
+}
+
+// ChangePassword sets a new password of the user if the current one is right.
+// Other sessions of the user are ended, the current one stays.
+func (s *AuthService) ChangePassword(ctx context.Context, currentPassword, newPassword string) error {
+	user, err := s.profileUser(ctx)
+	if err != nil {
+		return err
+	}
+	if !user.Auth([]byte(currentPassword), s.hasher.Compare) {
+		return ErrInvalidCredentials
+	}
+	if err := Passwords.Check(newPassword); err != nil {
+		return err
+	}
+
+	hashedPasswd, err := s.hasher.Hash([]byte(newPassword))
+	if err != nil {
+		return err
+	}
+
+	_, err = s.repository.Update(ctx, user.ID(), func(user auth.User) (auth.User, error) {
+		member, ok := user.(interface{ UpdateHashedPassword([]byte) error })
+		if !ok {
+			return nil, ErrProfileNotEditable
+		}
+		return user, member.UpdateHashedPassword(hashedPasswd)
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.sessions.DeleteByMember(ctx, user.ID(), s.sessionFromContext(ctx))
+}
@@ -0,0 +1,145 @@
+package authservice_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apitokenstorage "PlantSite/internal/infra/api-token-storage"
+	"PlantSite/internal/models/auth"
+	authservice "PlantSite/internal/services/auth-service"
+	authmock "PlantSite/internal/services/auth-service/auth-mock"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfile(t *testing.T) {
+	ctx := context.Background()
+	sid := uuid.New()
+	updateFn := mock.AnythingOfType("func(auth.User) (auth.User, error)")
+
+	type env struct {
+		svc      *authservice.AuthService
+		repo     *authmock.MockAuthRepository
+		sessions *authmock.MockSessionStorage
+		hasher   *authmock.MockPasswdHasher
+		member   *auth.Member
+		ctx      context.Context
+	}
+
+	newEnv := func(t *testing.T) *env {
+		member, err := auth.NewMember("test", "test@example.com", []byte("oldhash"))
+		require.NoError(t, err)
+		member.MarkEmailVerified()
+
+		e := &env{
+			repo:     new(authmock.MockAuthRepository),
+			sessions: new(authmock.MockSessionStorage),
+			hasher:   new(authmock.MockPasswdHasher),
+			member:   member,
+		}
+		e.repo.On("Get", mock.Anything, member.ID()).Return(member, nil)
+		e.repo.On("Update", mock.Anything, member.ID(), updateFn).
+			Run(func(args mock.Arguments) {
+				fn := args.Get(2).(func(auth.User) (auth.User, error))
+				_, err := fn(member)
+				require.NoError(t, err)
+			}).
+			Return(member, nil)
+		e.sessions.On("Get", mock.Anything, sid).Return(&authservice.Session{
+			ID:        sid,
+			MemberID:  member.ID(),
+			ExpiresAt: time.Now().Add(time.Hour),
+			CreatedAt: time.Now(),
+		}, nil)
+		e.svc = authservice.NewAuthService(e.sessions, e.repo, e.hasher)
+		e.ctx = e.svc.Authenticate(ctx, sid)
+		return e
+	}
+
+	t.Run("ChangeName", func(t *testing.T) {
+		e := newEnv(t)
+		e.repo.On("GetByName", mock.Anything, "renamed").Return(nil, auth.ErrUserNotFound)
+
+		require.NoError(t, e.svc.ChangeName(e.ctx, "renamed"))
+		assert.Equal(t, "renamed", e.member.Name())
+	})
+
+	t.Run("ChangeNameTaken", func(t *testing.T) {
+		e := newEnv(t)
+		other, err := auth.NewMember("taken", "other@example.com", []byte("hash"))
+		require.NoError(t, err)
+		e.repo.On("GetByName", mock.Anything, "taken").Return(other, nil)
+
+		require.ErrorIs(t, e.svc.ChangeName(e.ctx, "taken"), authservice.ErrNameTaken)
+		e.repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ChangeEmailUnverifies", func(t *testing.T) {
+		e := newEnv(t)
+		e.hasher.On("Compare", []byte("oldhash"), []byte("old")).Return(true, nil)
+		e.repo.On("GetByEmail", mock.Anything, "new@example.com").Return(nil, auth.ErrUserNotFound)
+
+		require.NoError(t, e.svc.ChangeEmail(e.ctx, "old", "new@example.com"))
+		assert.Equal(t, "new@example.com", e.member.Email())
+		assert.False(t, e.member.EmailVerified())
+	})
+
+	t.Run("ChangeEmailWrongPassword", func(t *testing.T) {
+		e := newEnv(t)
+		e.hasher.On("Compare", []byte("oldhash"), []byte("wrong")).Return(false, nil)
+
+		err := e.svc.ChangeEmail(e.ctx, "wrong", "new@example.com")
+		require.ErrorIs(t, err, authservice.ErrInvalidCredentials)
+		e.repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ChangePassword", func(t *testing.T) {
+		e := newEnv(t)
+		e.hasher.On("Compare", []byte("oldhash"), []byte("old")).Return(true, nil)
+		e.hasher.On("Hash", []byte("new-password")).Return([]byte("newhash"), nil)
+		e.sessions.On("DeleteByMember", mock.Anything, e.member.ID(), sid).Return(nil)
+
+		require.NoError(t, e.svc.ChangePassword(e.ctx, "old", "new-password"))
+		assert.Equal(t, []byte("newhash"), e.member.HashedPassword())
+		e.sessions.AssertCalled(t, "DeleteByMember", mock.Anything, e.member.ID(), sid)
+	})
+
+	t.Run("ChangePasswordWrongCurrent", func(t *testing.T) {
+		e := newEnv(t)
+		e.hasher.On("Compare", []byte("oldhash"), []byte("wrong")).Return(false, nil)
+
+		err := e.svc.ChangePassword(e.ctx, "wrong", "new-password")
+		require.ErrorIs(t, err, authservice.ErrInvalidCredentials)
+		e.repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+		e.sessions.AssertNotCalled(t, "DeleteByMember", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ChangePasswordWeak", func(t *testing.T) {
+		e := newEnv(t)
+		e.hasher.On("Compare", []byte("oldhash"), []byte("old")).Return(true, nil)
+
+		err := e.svc.ChangePassword(e.ctx, "old", "new")
+		require.ErrorIs(t, err, authservice.ErrPasswordTooShort)
+		e.repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("NotAuthorized", func(t *testing.T) {
+		e := newEnv(t)
+
+		require.ErrorIs(t, e.svc.ChangeName(ctx, "renamed"), auth.ErrNotAuthorized)
+	})
+
+	t.Run("TokenCantChangeProfile", func(t *testing.T) {
+		e := newEnv(t)
+		tokens := authservice.NewAPITokenService(apitokenstorage.NewMapAPITokenStorage(), e.svc)
+		_, token, err := tokens.Create(e.ctx, "script", auth.ScopeAuthor)
+		require.NoError(t, err)
+
+		err = e.svc.ChangePassword(tokens.Authenticate(ctx, token), "old", "new-password")
+		require.ErrorIs(t, err, authservice.ErrSessionRequired)
+	})
+}
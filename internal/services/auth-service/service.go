@@ -2,7 +2,9 @@ package authservice
 
 import (
 	"PlantSite/internal/models/auth"
+	"bytes"
 	"context"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -32,6 +34,9 @@ func NewAuthService(sessions SessionStorage, repository auth.AuthRepository, has
 }
 
 func (s *AuthService) Register(ctx context.Context, name, email, password string) error {
+	if err := Passwords.Check(password); err != nil {
+		return err
+	}
 	hashedPasswd, err := s.hasher.Hash([]byte(password))
 	if err != nil {
 		return err
@@ -50,28 +55,82 @@ func (s *AuthService) Register(ctx context.Context, name, email, password string
 	return nil
 }
 
-func (s *AuthService) Login(ctx context.Context, identifier, password string) (uuid.UUID, error) {
+// Login creates a session of the user. Remembered sessions live longer,
+// so that the user stays logged in between browser restarts.
+func (s *AuthService) Login(ctx context.Context, identifier, password string, remember bool) (uuid.UUID, error) {
+	user, err := s.lookup(ctx, identifier)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return s.login(ctx, user, password, remember)
+}
+
+// lookup returns the user with the email or, failing that, with the name.
+func (s *AuthService) lookup(ctx context.Context, identifier string) (auth.User, error) {
 	user, err := s.repository.GetByEmail(ctx, identifier)
 	if err != nil {
-		user, err = s.repository.GetByName(ctx, identifier)
-		if err != nil {
-			return uuid.Nil, err
-		}
+		return s.repository.GetByName(ctx, identifier)
 	}
+	return user, nil
+}
 
+// login checks the password of the user and starts the session.
+func (s *AuthService) login(ctx context.Context, user auth.User, password string, remember bool) (uuid.UUID, error) {
 	if !user.Auth([]byte(password), s.hasher.Compare) {
 		return uuid.Nil, ErrInvalidCredentials
 	}
+	s.rehash(ctx, user, password)
+
+	return s.startSession(ctx, user, remember)
+}
+
+// rehash replaces the hash of the password made by an outdated algorithm or cost,
+// the password is known only on login. Failed rehash doesn't fail the login, it is tried again next time.
+func (s *AuthService) rehash(ctx context.Context, user auth.User, password string) {
+	rehasher, ok := s.hasher.(PasswdRehasher)
+	if !ok {
+		return
+	}
+	hashed, ok := user.(interface{ HashedPassword() []byte })
+	if !ok || !rehasher.NeedsRehash(hashed.HashedPassword()) {
+		return
+	}
+	oldHash := hashed.HashedPassword()
 
+	newHash, err := s.hasher.Hash([]byte(password))
+	if err != nil {
+		return
+	}
+	_, _ = s.repository.Update(ctx, user.ID(), func(user auth.User) (auth.User, error) {
+		member, ok := user.(interface {
+			HashedPassword() []byte
+			UpdateHashedPassword([]byte) error
+		})
+		// The password was changed meanwhile
+		if !ok || !bytes.Equal(member.HashedPassword(), oldHash) {
+			return nil, ErrPasswordChanged
+		}
+		return user, member.UpdateHashedPassword(newHash)
+	})
+}
+
+// startSession stores a new session of the authenticated user.
+func (s *AuthService) startSession(ctx context.Context, user auth.User, remember bool) (uuid.UUID, error) {
 	sid := uuid.New()
+	now := time.Now()
+	client := clientFromContext(ctx)
 	session := &Session{
-		ID:        sid,
-		MemberID:  user.ID(),
-		ExpiresAt: time.Now().Add(SessionExpireTime),
+		ID:         sid,
+		MemberID:   user.ID(),
+		CreatedAt:  now,
+		Remember:   remember,
+		LastSeenAt: now,
+		UserAgent:  client.UserAgent,
+		IP:         client.IP,
 	}
+	session.ExpiresAt = now.Add(session.expireTime())
 
-	err = s.sessions.Store(ctx, sid, session)
-	if err != nil {
+	if err := s.sessions.Store(ctx, sid, session); err != nil {
 		return uuid.Nil, err
 	}
 
@@ -94,10 +153,18 @@ func (s *AuthService) authenticate(ctx context.Context, sid uuid.UUID) (uuid.UUI
 		return uuid.Nil, err
 	}
 
-	if session.ExpiresAt.Before(time.Now()) {
+	now := time.Now()
+	if session.ExpiresAt.Before(now) {
 		return uuid.Nil, ErrSessionExpired
 	}
 
+	// Failed renewal doesn't fail the request, the session just isn't prolonged
+	renewed, renew := session.renewed(now)
+	seen, see := renewed.seen(now, clientFromContext(ctx).IP)
+	if renew || see {
+		_ = s.sessions.Touch(ctx, sid, seen)
+	}
+
 	return session.MemberID, nil
 }
 
@@ -110,23 +177,76 @@ func (s *AuthService) Authenticate(ctx context.Context, sid uuid.UUID) context.C
 	ctx = context.WithValue(ctx, AuthContextKey, userID)
 	ctx = context.WithValue(ctx, sessionContextKey, sid)
 
-	return ctx
+	return withRequestUser(ctx)
 }
 
+// requestUser holds the user of a request, so that it is resolved once however many services ask for it.
+type requestUser struct {
+	once sync.Once
+	user auth.User
+}
+
+// withRequestUser prepares the context of an authenticated request for UserFromContext.
+func withRequestUser(ctx context.Context) context.Context {
+	return context.WithValue(ctx, userContextKey, &requestUser{})
+}
+
+// UserFromContext returns the user of the request. The user is loaded on the first call
+// and the later calls of the request get the same user.
 func (s *AuthService) UserFromContext(ctx context.Context) auth.User {
-	if userID, ok := ctx.Value(AuthContextKey).(uuid.UUID); ok {
-		if userID == uuid.Nil {
-			return auth.NewNoAuthUser()
-		}
-		s.repository.Get(ctx, userID)
-		user, err := s.repository.Get(ctx, userID)
-		if err != nil {
-			return auth.NewNoAuthUser()
-		}
-		return user
+	resolved, ok := ctx.Value(userContextKey).(*requestUser)
+	if !ok {
+		return s.resolveUser(ctx)
+	}
+	resolved.once.Do(func() {
+		resolved.user = s.resolveUser(ctx)
+	})
+	return resolved.user
+}
+
+func (s *AuthService) resolveUser(ctx context.Context) auth.User {
+	userID, ok := ctx.Value(AuthContextKey).(uuid.UUID)
+	if !ok || userID == uuid.Nil {
+		return auth.NewNoAuthUser()
+	}
+	user, err := s.repository.Get(ctx, userID)
+	if err != nil {
+		return auth.NewNoAuthUser()
 	}
+	if scope, ok := ctx.Value(tokenScopeContextKey).(auth.TokenScope); ok {
+		return auth.NewScopedUser(user, scope)
+	}
+	return user
+}
+
+// Authorize returns the user of the request if the user has the permission.
+func (s *AuthService) Authorize(ctx context.Context, perm auth.Permission) (auth.User, error) {
+	user := s.UserFromContext(ctx)
+	if err := Policy.Authorize(user, perm); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// Can reports whether the user of the request has the permission.
+func (s *AuthService) Can(ctx context.Context, perm auth.Permission) bool {
+	return Policy.Can(s.UserFromContext(ctx), perm)
+}
+
+// Client is the device a request comes from, it is shown in the list of sessions.
+type Client struct {
+	UserAgent string
+	IP        string
+}
+
+// WithClient adds the client of the request to the context, new sessions remember it.
+func WithClient(ctx context.Context, client Client) context.Context {
+	return context.WithValue(ctx, clientContextKey, client)
+}
 
-	return auth.NewNoAuthUser()
+func clientFromContext(ctx context.Context) Client {
+	client, _ := ctx.Value(clientContextKey).(Client)
+	return client
 }
 
 func (s *AuthService) sessionFromContext(ctx context.Context) uuid.UUID {
@@ -2,15 +2,22 @@ package authservice_test
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
+	"PlantSite/internal/models/auth"
 	authservice "PlantSite/internal/services/auth-service"
 	authmock "PlantSite/internal/services/auth-service/auth-mock"
+	"PlantSite/internal/utils/argon2hasher"
+	"PlantSite/internal/utils/bcrypthasher"
+	"PlantSite/internal/utils/multihasher"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
 )
 
 func TestAuthService(t *testing.T) {
@@ -87,7 +94,7 @@ func TestAuthService(t *testing.T) {
 
 			svc := authservice.NewAuthService(sessions, repo, hasher)
 
-			sid, err := svc.Login(ctx, validEmail, validPassword)
+			sid, err := svc.Login(ctx, validEmail, validPassword, false)
 			require.NoError(t, err)
 			assert.NotEqual(t, uuid.Nil, sid)
 
@@ -111,7 +118,7 @@ func TestAuthService(t *testing.T) {
 
 			svc := authservice.NewAuthService(sessions, repo, hasher)
 
-			sid, err := svc.Login(ctx, validName, validPassword)
+			sid, err := svc.Login(ctx, validName, validPassword, false)
 			require.NoError(t, err)
 			assert.NotEqual(t, uuid.Nil, sid)
 		})
@@ -128,7 +135,7 @@ func TestAuthService(t *testing.T) {
 
 			svc := authservice.NewAuthService(sessions, repo, hasher)
 
-			_, err := svc.Login(ctx, validEmail, "wrongpassword")
+			_, err := svc.Login(ctx, validEmail, "wrongpassword", false)
 			require.Error(t, err)
 			assert.ErrorIs(t, err, authservice.ErrInvalidCredentials)
 		})
@@ -143,7 +150,7 @@ func TestAuthService(t *testing.T) {
 
 			svc := authservice.NewAuthService(sessions, repo, hasher)
 
-			_, err := svc.Login(ctx, validEmail, validPassword)
+			_, err := svc.Login(ctx, validEmail, validPassword, false)
 			require.Error(t, err)
 		})
 
@@ -161,7 +168,7 @@ func TestAuthService(t *testing.T) {
 
 			svc := authservice.NewAuthService(sessions, repo, hasher)
 
-			_, err := svc.Login(ctx, validEmail, validPassword)
+			_, err := svc.Login(ctx, validEmail, validPassword, false)
 			require.Error(t, err)
 			assert.ErrorIs(t, err, assert.AnError)
 		})
@@ -300,3 +307,276 @@ func TestAuthService(t *testing.T) {
 	// 	})
 	// })
 }
+
+func TestSessionLifetime(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	password := "securepassword"
+	sessionType := mock.AnythingOfType("*authservice.Session")
+
+	loginUser := func(t *testing.T, remember bool) *authservice.Session {
+		repo := new(authmock.MockAuthRepository)
+		sessions := new(authmock.MockSessionStorage)
+		hasher := new(authmock.MockPasswdHasher)
+
+		mockUser := new(authmock.MockUser)
+		mockUser.On("ID").Return(userID)
+		mockUser.On("Auth", []byte(password), mock.AnythingOfType("func([]uint8, []uint8) (bool, error)")).Return(true)
+		repo.On("GetByEmail", ctx, "test@example.com").Return(mockUser, nil)
+
+		var stored *authservice.Session
+		sessions.On("Store", ctx, mock.AnythingOfType("uuid.UUID"), sessionType).
+			Run(func(args mock.Arguments) { stored = args.Get(2).(*authservice.Session) }).
+			Return(nil)
+
+		svc := authservice.NewAuthService(sessions, repo, hasher)
+		_, err := svc.Login(ctx, "test@example.com", password, remember)
+		require.NoError(t, err)
+		require.NotNil(t, stored)
+		return stored
+	}
+
+	// authenticate returns the session stored by authentication, or nil if it wasn't renewed
+	authenticate := func(t *testing.T, session *authservice.Session) *authservice.Session {
+		sessions := new(authmock.MockSessionStorage)
+		sessions.On("Get", ctx, session.ID).Return(session, nil)
+
+		var stored *authservice.Session
+		sessions.On("Touch", ctx, session.ID, sessionType).
+			Run(func(args mock.Arguments) { stored = args.Get(2).(*authservice.Session) }).
+			Return(nil)
+
+		svc := authservice.NewAuthService(sessions, new(authmock.MockAuthRepository), new(authmock.MockPasswdHasher))
+		svc.Authenticate(ctx, session.ID)
+		return stored
+	}
+
+	t.Run("Login", func(t *testing.T) {
+		session := loginUser(t, false)
+		assert.False(t, session.Remember)
+		assert.WithinDuration(t, time.Now().Add(authservice.SessionExpireTime), session.ExpiresAt, time.Second)
+	})
+
+	t.Run("LoginRemembersClient", func(t *testing.T) {
+		ctx := authservice.WithClient(ctx, authservice.Client{UserAgent: "Firefox", IP: "10.0.0.1"})
+		repo := new(authmock.MockAuthRepository)
+		sessions := new(authmock.MockSessionStorage)
+		mockUser := new(authmock.MockUser)
+		mockUser.On("ID").Return(userID)
+		mockUser.On("Auth", []byte(password), mock.AnythingOfType("func([]uint8, []uint8) (bool, error)")).Return(true)
+		repo.On("GetByEmail", ctx, "test@example.com").Return(mockUser, nil)
+		sessions.On("Store", ctx, mock.AnythingOfType("uuid.UUID"), sessionType).Return(nil)
+
+		svc := authservice.NewAuthService(sessions, repo, new(authmock.MockPasswdHasher))
+		_, err := svc.Login(ctx, "test@example.com", password, false)
+		require.NoError(t, err)
+		sessions.AssertCalled(t, "Store", ctx, mock.Anything, mock.MatchedBy(func(s *authservice.Session) bool {
+			return s.UserAgent == "Firefox" && s.IP == "10.0.0.1" && !s.LastSeenAt.IsZero()
+		}))
+	})
+
+	t.Run("LoginRemember", func(t *testing.T) {
+		session := loginUser(t, true)
+		assert.True(t, session.Remember)
+		assert.WithinDuration(t, time.Now().Add(authservice.RememberSessionExpireTime), session.ExpiresAt, time.Second)
+	})
+
+	t.Run("FreshSessionNotRenewed", func(t *testing.T) {
+		session := &authservice.Session{
+			ID:        uuid.New(),
+			MemberID:  userID,
+			CreatedAt: time.Now(),
+			ExpiresAt: time.Now().Add(authservice.SessionExpireTime),
+		}
+		assert.Nil(t, authenticate(t, session))
+	})
+
+	t.Run("LastSeenRecorded", func(t *testing.T) {
+		session := &authservice.Session{
+			ID:         uuid.New(),
+			MemberID:   userID,
+			CreatedAt:  time.Now(),
+			ExpiresAt:  time.Now().Add(authservice.SessionExpireTime),
+			LastSeenAt: time.Now().Add(-authservice.SessionLastSeenInterval),
+			IP:         "10.0.0.1",
+		}
+		seen := authenticate(t, session)
+		require.NotNil(t, seen)
+		assert.WithinDuration(t, time.Now(), seen.LastSeenAt, time.Second)
+		assert.Equal(t, session.ExpiresAt, seen.ExpiresAt)
+		assert.Equal(t, "10.0.0.1", seen.IP)
+	})
+
+	t.Run("IdleSessionRenewed", func(t *testing.T) {
+		session := &authservice.Session{
+			ID:        uuid.New(),
+			MemberID:  userID,
+			CreatedAt: time.Now().Add(-authservice.SessionExpireTime),
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+		renewed := authenticate(t, session)
+		require.NotNil(t, renewed)
+		assert.WithinDuration(t, time.Now().Add(authservice.SessionExpireTime), renewed.ExpiresAt, time.Second)
+		assert.Equal(t, session.CreatedAt, renewed.CreatedAt)
+	})
+
+	t.Run("RenewalCappedByMaxLifetime", func(t *testing.T) {
+		createdAt := time.Now().Add(-authservice.SessionMaxLifetime + 10*time.Minute)
+		session := &authservice.Session{
+			ID:        uuid.New(),
+			MemberID:  userID,
+			CreatedAt: createdAt,
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+		renewed := authenticate(t, session)
+		require.NotNil(t, renewed)
+		assert.Equal(t, createdAt.Add(authservice.SessionMaxLifetime), renewed.ExpiresAt)
+	})
+
+	t.Run("MaxLifetimeReached", func(t *testing.T) {
+		createdAt := time.Now().Add(-authservice.SessionMaxLifetime + time.Minute)
+		session := &authservice.Session{
+			ID:        uuid.New(),
+			MemberID:  userID,
+			CreatedAt: createdAt,
+			ExpiresAt: createdAt.Add(authservice.SessionMaxLifetime),
+		}
+		assert.Nil(t, authenticate(t, session))
+	})
+
+	t.Run("RememberSessionRenewed", func(t *testing.T) {
+		session := &authservice.Session{
+			ID:        uuid.New(),
+			MemberID:  userID,
+			CreatedAt: time.Now().Add(-authservice.RememberSessionExpireTime),
+			ExpiresAt: time.Now().Add(time.Hour),
+			Remember:  true,
+		}
+		renewed := authenticate(t, session)
+		require.NotNil(t, renewed)
+		assert.WithinDuration(t, time.Now().Add(authservice.RememberSessionExpireTime), renewed.ExpiresAt, time.Second)
+	})
+}
+
+func TestUserFromContext(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	sid := uuid.New()
+
+	newService := func(t *testing.T) (*authservice.AuthService, *authmock.MockAuthRepository) {
+		repo := new(authmock.MockAuthRepository)
+		sessions := new(authmock.MockSessionStorage)
+		sessions.On("Get", mock.Anything, sid).Return(&authservice.Session{
+			ID:        sid,
+			MemberID:  userID,
+			ExpiresAt: time.Now().Add(time.Hour),
+			CreatedAt: time.Now(),
+		}, nil)
+		return authservice.NewAuthService(sessions, repo, new(authmock.MockPasswdHasher)), repo
+	}
+
+	t.Run("ResolvedOncePerRequest", func(t *testing.T) {
+		svc, repo := newService(t)
+		mockUser := new(authmock.MockUser)
+		mockUser.On("ID").Return(userID)
+		repo.On("Get", mock.Anything, userID).Return(mockUser, nil)
+
+		reqCtx := svc.Authenticate(ctx, sid)
+		for range 3 {
+			assert.Equal(t, userID, svc.UserFromContext(reqCtx).ID())
+		}
+		// Contexts derived during the request share the user
+		svc.UserFromContext(context.WithValue(reqCtx, struct{}{}, "derived"))
+		repo.AssertNumberOfCalls(t, "Get", 1)
+
+		svc.UserFromContext(svc.Authenticate(ctx, sid))
+		repo.AssertNumberOfCalls(t, "Get", 2)
+	})
+
+	t.Run("FailedResolutionNotRetried", func(t *testing.T) {
+		svc, repo := newService(t)
+		repo.On("Get", mock.Anything, userID).Return(nil, assert.AnError)
+
+		reqCtx := svc.Authenticate(ctx, sid)
+		assert.False(t, svc.UserFromContext(reqCtx).IsAuthenticated())
+		assert.False(t, svc.UserFromContext(reqCtx).IsAuthenticated())
+		repo.AssertNumberOfCalls(t, "Get", 1)
+	})
+}
+
+func TestLoginRehash(t *testing.T) {
+	ctx := context.Background()
+	password := "securepassword"
+	argon := argon2hasher.NewArgon2Hasher(argon2hasher.Params{
+		Memory:      64,
+		Iterations:  1,
+		Parallelism: 1,
+		SaltLength:  16,
+		KeyLength:   32,
+	})
+	legacy := bcrypthasher.NewBcryptHasher(bcrypt.MinCost)
+	hasher := multihasher.NewMultiHasher(argon, legacy)
+
+	login := func(t *testing.T, member auth.User, email string, updateErr error) *authmock.MockAuthRepository {
+		repo := new(authmock.MockAuthRepository)
+		sessions := new(authmock.MockSessionStorage)
+		repo.On("GetByEmail", ctx, email).Return(member, nil)
+		repo.On("Update", ctx, member.ID(), mock.Anything).
+			Run(func(args mock.Arguments) {
+				fn := args.Get(2).(func(auth.User) (auth.User, error))
+				_, err := fn(member)
+				require.NoError(t, err)
+			}).
+			Return(member, updateErr)
+		sessions.On("Store", ctx, mock.Anything, mock.Anything).Return(nil)
+
+		svc := authservice.NewAuthService(sessions, repo, hasher)
+		_, err := svc.Login(ctx, email, password, false)
+		require.NoError(t, err)
+		return repo
+	}
+
+	t.Run("LegacyHashUpgraded", func(t *testing.T) {
+		hashed, err := legacy.Hash([]byte(password))
+		require.NoError(t, err)
+		member, err := auth.NewMember("test", "test@example.com", hashed)
+		require.NoError(t, err)
+
+		repo := login(t, member, member.Email(), nil)
+		repo.AssertCalled(t, "Update", ctx, member.ID(), mock.Anything)
+		assert.True(t, strings.HasPrefix(string(member.HashedPassword()), "$argon2id$"))
+		match, err := hasher.Compare(member.HashedPassword(), []byte(password))
+		require.NoError(t, err)
+		assert.True(t, match)
+	})
+
+	t.Run("AdminHashUpgraded", func(t *testing.T) {
+		hashed, err := legacy.Hash([]byte(password))
+		require.NoError(t, err)
+		admin, err := auth.NewAdmin("admin", hashed)
+		require.NoError(t, err)
+
+		repo := login(t, admin, "admin@example.com", nil)
+		repo.AssertCalled(t, "Update", ctx, admin.ID(), mock.Anything)
+		assert.True(t, strings.HasPrefix(string(admin.HashedPassword()), "$argon2id$"))
+	})
+
+	t.Run("CurrentHashKept", func(t *testing.T) {
+		hashed, err := hasher.Hash([]byte(password))
+		require.NoError(t, err)
+		member, err := auth.NewMember("test", "test@example.com", hashed)
+		require.NoError(t, err)
+
+		repo := login(t, member, member.Email(), nil)
+		repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("FailedRehashDoesntFailLogin", func(t *testing.T) {
+		hashed, err := legacy.Hash([]byte(password))
+		require.NoError(t, err)
+		member, err := auth.NewMember("test", "test@example.com", hashed)
+		require.NoError(t, err)
+
+		login(t, member, member.Email(), assert.AnError)
+	})
+}
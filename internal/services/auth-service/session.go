@@ -12,6 +12,13 @@ type Session struct {
 	ID        uuid.UUID
 	MemberID  uuid.UUID
 	ExpiresAt time.Time
+	CreatedAt time.Time
+	// Remember marks long-lived sessions the user asked to keep on login
+	Remember bool
+	// LastSeenAt, UserAgent and IP let the user recognize the session in the list of their sessions
+	LastSeenAt time.Time
+	UserAgent  string
+	IP         string
 }
 
 func NewSession(id uuid.UUID, memberID uuid.UUID, expiresAt time.Time) (*Session, error) {
@@ -21,16 +28,77 @@ func NewSession(id uuid.UUID, memberID uuid.UUID, expiresAt time.Time) (*Session
 	if memberID == uuid.Nil {
 		return nil, fmt.Errorf("session member ID cannot be nil")
 	}
+	now := time.Now()
 	return &Session{
-		ID:        id,
-		MemberID:  memberID,
-		ExpiresAt: expiresAt,
+		ID:         id,
+		MemberID:   memberID,
+		ExpiresAt:  expiresAt,
+		CreatedAt:  now,
+		LastSeenAt: now,
 	}, nil
 }
 
+func (s *Session) expireTime() time.Duration {
+	if s.Remember {
+		return RememberSessionExpireTime
+	}
+	return SessionExpireTime
+}
+
+func (s *Session) maxLifetime() time.Duration {
+	if s.Remember {
+		return RememberSessionMaxLifetime
+	}
+	return SessionMaxLifetime
+}
+
+// renewed returns the session with the idle timeout restarted at now, capped by the session max lifetime.
+// To avoid storing the session on every request, it is renewed only after half of the idle timeout has passed.
+func (s *Session) renewed(now time.Time) (*Session, bool) {
+	expireTime := s.expireTime()
+	if s.ExpiresAt.Sub(now) > expireTime/2 {
+		return s, false
+	}
+	expiresAt := now.Add(expireTime)
+	if deadline := s.CreatedAt.Add(s.maxLifetime()); expiresAt.After(deadline) {
+		expiresAt = deadline
+	}
+	if !expiresAt.After(s.ExpiresAt) {
+		return s, false
+	}
+	renewed := *s
+	renewed.ExpiresAt = expiresAt
+	return &renewed, true
+}
+
+// seen returns the session used at now from the ip. Like renewal, the activity is
+// recorded only after SessionLastSeenInterval, so that not every request stores the session.
+// Sessions without LastSeenAt don't track activity.
+func (s *Session) seen(now time.Time, ip string) (*Session, bool) {
+	if s.LastSeenAt.IsZero() {
+		return s, false
+	}
+	if now.Sub(s.LastSeenAt) < SessionLastSeenInterval && (ip == "" || ip == s.IP) {
+		return s, false
+	}
+	seen := *s
+	seen.LastSeenAt = now
+	if ip != "" {
+		seen.IP = ip
+	}
+	return &seen, true
+}
+
 type SessionStorage interface {
 	Get(ctx context.Context, sid uuid.UUID) (*Session, error)
 	Store(ctx context.Context, sid uuid.UUID, session *Session) error
+	// Touch updates the expiry and the activity of a stored session. Unlike Store it never
+	// brings back a session deleted meanwhile, it returns ErrSessionNotFound instead.
+	Touch(ctx context.Context, sid uuid.UUID, session *Session) error
 	Delete(ctx context.Context, sid uuid.UUID) error
+	// ListByMember returns the unexpired sessions of the member.
+	ListByMember(ctx context.Context, memberID uuid.UUID) ([]*Session, error)
+	// DeleteByMember ends all sessions of the member but the except one, uuid.Nil ends all of them.
+	DeleteByMember(ctx context.Context, memberID uuid.UUID, except uuid.UUID) error
 	ClearExpired(ctx context.Context) error
 }
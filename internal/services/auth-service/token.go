@@ -0,0 +1,75 @@
+package authservice
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const memberTokenLength = 32
+
+// MemberToken is a single-use token mailed to a member, like a password reset token.
+// It is stored by the hash of the token, so that leaked storage can't be used instead of the mail.
+type MemberToken struct {
+	TokenHash []byte
+	MemberID  uuid.UUID
+	ExpiresAt time.Time
+}
+
+type MemberTokenStorage interface {
+	Store(ctx context.Context, token *MemberToken) error
+	// Consume removes the token and returns it, so that it can be used only once.
+	Consume(ctx context.Context, tokenHash []byte) (*MemberToken, error)
+	ClearExpired(ctx context.Context) error
+}
+
+func newMemberToken() (string, error) {
+	buf := make([]byte, memberTokenLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func hashMemberToken(token string) []byte {
+	hash := sha256.Sum256([]byte(token))
+	return hash[:]
+}
+
+// issueMemberToken stores a new token of the member and returns it to be mailed.
+func issueMemberToken(ctx context.Context, tokens MemberTokenStorage, memberID uuid.UUID, expireTime time.Duration) (string, error) {
+	token, err := newMemberToken()
+	if err != nil {
+		return "", err
+	}
+	err = tokens.Store(ctx, &MemberToken{
+		TokenHash: hashMemberToken(token),
+		MemberID:  memberID,
+		ExpiresAt: time.Now().Add(expireTime),
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// consumeMemberToken uses up the token, expired tokens are consumed as well.
+func consumeMemberToken(ctx context.Context, tokens MemberTokenStorage, token string) (*MemberToken, error) {
+	memberToken, err := tokens.Consume(ctx, hashMemberToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if memberToken.ExpiresAt.Before(time.Now()) {
+		return nil, ErrInvalidToken
+	}
+	return memberToken, nil
+}
+
+func tokenLink(pageURL, token string) string {
+	return pageURL + "?" + url.Values{"token": {token}}.Encode()
+}
@@ -17,15 +17,12 @@ type CreatePlantData struct {
 }
 
 func (s *PlantService) CreatePlant(ctx context.Context, data CreatePlantData, mainPhotoFile models.FileData) error {
-	user := s.auth.UserFromContext(ctx)
-	if user == nil {
-		return auth.ErrNotAuthorized
-	}
-	if !user.HasAuthorRights() {
-		return auth.ErrNoAuthorRights
+	_, err := s.auth.Authorize(ctx, auth.PermPlantEdit)
+	if err != nil {
+		return err
 	}
 
-	_, err := s.categoryrepo.GetCategory(ctx, data.Category)
+	_, err = s.categoryrepo.GetCategory(ctx, data.Category)
 	if err != nil {
 		return Wrap(err)
 	}
@@ -29,12 +29,9 @@ type GetPlantPhoto struct {
 }
 
 func (s *PlantService) GetPlant(ctx context.Context, id uuid.UUID) (*GetPlant, error) {
-	user := s.auth.UserFromContext(ctx)
-	if user == nil {
-		return nil, auth.ErrNotAuthorized
-	}
-	if !user.HasAuthorRights() {
-		return nil, auth.ErrNoAuthorRights
+	_, err := s.auth.Authorize(ctx, auth.PermPlantEdit)
+	if err != nil {
+		return nil, err
 	}
 	pl, err := s.plantrepo.Get(ctx, id)
 	if err != nil {
@@ -0,0 +1,74 @@
+package plantservice
+
+import (
+	"PlantSite/internal/models/auth"
+	"PlantSite/internal/models/plant"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// DeletePlantPhoto removes the photo from the plant gallery and deletes its file.
+func (s *PlantService) DeletePlantPhoto(ctx context.Context, id uuid.UUID, photoID uuid.UUID) error {
+	_, err := s.auth.Authorize(ctx, auth.PermPlantEdit)
+	if err != nil {
+		return err
+	}
+	var fileID uuid.UUID
+	_, err = s.plantrepo.Update(ctx, id, func(p *plant.Plant) (*plant.Plant, error) {
+		photos := p.GetPhotos()
+		photo, err := photos.Get(photoID)
+		if err != nil {
+			return nil, err
+		}
+		fileID = photo.FileID()
+		if err := p.DeletePhoto(photoID); err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+	if err != nil {
+		return Wrap(err)
+	}
+	// The file can only be deleted once no plant photo refers to it.
+	// The photo is already gone, so a failure here only wastes space.
+	if err := s.filerepo.Delete(ctx, fileID); err != nil {
+		logger.Errorw("Failed to delete orphaned plant photo file", "file", fileID, "error", err)
+	}
+	return nil
+}
+
+func (s *PlantService) UpdatePlantPhotoDescription(ctx context.Context, id uuid.UUID, photoID uuid.UUID, description string) error {
+	_, err := s.auth.Authorize(ctx, auth.PermPlantEdit)
+	if err != nil {
+		return err
+	}
+	_, err = s.plantrepo.Update(ctx, id, func(p *plant.Plant) (*plant.Plant, error) {
+		if err := p.UpdatePhotoDescription(photoID, description); err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+	if err != nil {
+		return Wrap(err)
+	}
+	return nil
+}
+
+// ReorderPlantPhotos places the gallery photos in the given order.
+func (s *PlantService) ReorderPlantPhotos(ctx context.Context, id uuid.UUID, photoIDs []uuid.UUID) error {
+	_, err := s.auth.Authorize(ctx, auth.PermPlantEdit)
+	if err != nil {
+		return err
+	}
+	_, err = s.plantrepo.Update(ctx, id, func(p *plant.Plant) (*plant.Plant, error) {
+		if err := p.ReorderPhotos(photoIDs); err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+	if err != nil {
+		return Wrap(err)
+	}
+	return nil
+}
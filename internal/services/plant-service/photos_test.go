@@ -0,0 +1,161 @@
+package plantservice_test
+
+import (
+	"testing"
+
+	"PlantSite/internal/models/plant"
+	authmock "PlantSite/internal/services/auth-service/auth-mock"
+	plantservice "PlantSite/internal/services/plant-service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestDeletePlantPhoto(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(uuid.New())
+		ctx, asvc, _ := authmock.Authenticate(user)
+		photo, err := plant.NewPlantPhoto(uuid.New(), "leaves")
+		require.NoError(t, err)
+		pl := plantWithPhotos(t, photo)
+
+		prepo := new(MockPlantRepository)
+		frepo := new(MockFileRepository)
+		prepo.On("Update", mock.Anything, pl.ID(), mock.Anything).Return(pl, nil)
+		frepo.On("Delete", mock.Anything, photo.FileID()).Return(nil)
+
+		svc := plantservice.NewPlantService(prepo, new(MockPlantCategoryRepository), frepo, asvc)
+		require.NoError(t, svc.DeletePlantPhoto(ctx, pl.ID(), photo.ID()))
+
+		assert.Equal(t, 0, pl.GetPhotos().Len())
+		frepo.AssertExpectations(t)
+	})
+
+	t.Run("FileDeleteError", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(uuid.New())
+		ctx, asvc, _ := authmock.Authenticate(user)
+		photo, err := plant.NewPlantPhoto(uuid.New(), "leaves")
+		require.NoError(t, err)
+		pl := plantWithPhotos(t, photo)
+
+		core, logs := observer.New(zapcore.ErrorLevel)
+		plantservice.UpdateLogger(zap.New(core).Sugar())
+		t.Cleanup(func() { plantservice.UpdateLogger(zap.NewNop().Sugar()) })
+
+		prepo := new(MockPlantRepository)
+		frepo := new(MockFileRepository)
+		prepo.On("Update", mock.Anything, pl.ID(), mock.Anything).Return(pl, nil)
+		frepo.On("Delete", mock.Anything, photo.FileID()).Return(assert.AnError)
+
+		svc := plantservice.NewPlantService(prepo, new(MockPlantCategoryRepository), frepo, asvc)
+		require.NoError(t, svc.DeletePlantPhoto(ctx, pl.ID(), photo.ID()))
+
+		assert.Equal(t, 0, pl.GetPhotos().Len())
+		assert.Equal(t, 1, logs.Len())
+	})
+
+	t.Run("PhotoNotFound", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(uuid.New())
+		ctx, asvc, _ := authmock.Authenticate(user)
+		pl := plantWithPhotos(t)
+
+		prepo := new(MockPlantRepository)
+		frepo := new(MockFileRepository)
+		prepo.On("Update", mock.Anything, pl.ID(), mock.Anything).Return(pl, nil)
+
+		svc := plantservice.NewPlantService(prepo, new(MockPlantCategoryRepository), frepo, asvc)
+		err := svc.DeletePlantPhoto(ctx, pl.ID(), uuid.New())
+		require.ErrorIs(t, err, plant.ErrPlantPhotoNotFound)
+		frepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("UpdateError", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(uuid.New())
+		ctx, asvc, _ := authmock.Authenticate(user)
+
+		prepo := new(MockPlantRepository)
+		frepo := new(MockFileRepository)
+		prepo.On("Update", mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError)
+
+		svc := plantservice.NewPlantService(prepo, new(MockPlantCategoryRepository), frepo, asvc)
+		err := svc.DeletePlantPhoto(ctx, uuid.New(), uuid.New())
+		require.ErrorIs(t, err, assert.AnError)
+		frepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
+
+func TestUpdatePlantPhotoDescription(t *testing.T) {
+	user := new(authmock.MockUser)
+	user.On("HasAuthorRights").Return(true)
+	user.On("ID").Return(uuid.New())
+	ctx, asvc, _ := authmock.Authenticate(user)
+	photo, err := plant.NewPlantPhoto(uuid.New(), "leaves")
+	require.NoError(t, err)
+	pl := plantWithPhotos(t, photo)
+
+	prepo := new(MockPlantRepository)
+	prepo.On("Update", mock.Anything, pl.ID(), mock.Anything).Return(pl, nil)
+
+	svc := plantservice.NewPlantService(prepo, new(MockPlantCategoryRepository), new(MockFileRepository), asvc)
+	require.NoError(t, svc.UpdatePlantPhotoDescription(ctx, pl.ID(), photo.ID(), "autumn leaves"))
+
+	updated, err := pl.GetPhotos().Get(photo.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "autumn leaves", updated.Description())
+}
+
+func TestReorderPlantPhotos(t *testing.T) {
+	first, err := plant.NewPlantPhoto(uuid.New(), "first")
+	require.NoError(t, err)
+	second, err := plant.NewPlantPhoto(uuid.New(), "second")
+	require.NoError(t, err)
+
+	t.Run("Success", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(uuid.New())
+		ctx, asvc, _ := authmock.Authenticate(user)
+		pl := plantWithPhotos(t, first, second)
+
+		prepo := new(MockPlantRepository)
+		prepo.On("Update", mock.Anything, pl.ID(), mock.Anything).Return(pl, nil)
+
+		svc := plantservice.NewPlantService(prepo, new(MockPlantCategoryRepository), new(MockFileRepository), asvc)
+		require.NoError(t, svc.ReorderPlantPhotos(ctx, pl.ID(), []uuid.UUID{second.ID(), first.ID()}))
+
+		var order []uuid.UUID
+		_ = pl.GetPhotos().Iterate(func(e plant.PlantPhoto) error {
+			order = append(order, e.ID())
+			return nil
+		})
+		assert.Equal(t, []uuid.UUID{second.ID(), first.ID()}, order)
+	})
+
+	t.Run("InvalidOrder", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(uuid.New())
+		ctx, asvc, _ := authmock.Authenticate(user)
+		pl := plantWithPhotos(t, first, second)
+
+		prepo := new(MockPlantRepository)
+		prepo.On("Update", mock.Anything, pl.ID(), mock.Anything).Return(pl, nil)
+
+		svc := plantservice.NewPlantService(prepo, new(MockPlantCategoryRepository), new(MockFileRepository), asvc)
+		err := svc.ReorderPlantPhotos(ctx, pl.ID(), []uuid.UUID{first.ID()})
+		require.ErrorIs(t, err, plant.ErrInvalidPhotoOrder)
+	})
+}
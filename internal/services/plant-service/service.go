@@ -8,8 +8,17 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 )
 
+// logger reports failures that don't fail the request, such as files left behind.
+var logger = zap.NewNop().Sugar()
+
+// UpdateLogger sets the logger of the plant service.
+func UpdateLogger(l *zap.SugaredLogger) {
+	logger = l
+}
+
 type PlantService struct {
 	plantrepo    plant.PlantRepository
 	categoryrepo plant.PlantCategoryRepository
@@ -39,14 +48,11 @@ func NewPlantService(repository plant.PlantRepository, crep plant.PlantCategoryR
 
 func (s *PlantService) UpdatePlantSpec(ctx context.Context, id uuid.UUID, spec plant.PlantSpecification) error {
 
-	user := s.auth.UserFromContext(ctx)
-	if user == nil {
-		return auth.ErrNotAuthorized
-	}
-	if !user.HasAuthorRights() {
-		return auth.ErrNoAuthorRights
+	_, err := s.auth.Authorize(ctx, auth.PermPlantEdit)
+	if err != nil {
+		return err
 	}
-	_, err := s.plantrepo.Update(ctx, id, func(p *plant.Plant) (*plant.Plant, error) {
+	_, err = s.plantrepo.Update(ctx, id, func(p *plant.Plant) (*plant.Plant, error) {
 		err := p.UpdateSpec(spec)
 		return p, err
 	})
@@ -54,23 +60,17 @@ func (s *PlantService) UpdatePlantSpec(ctx context.Context, id uuid.UUID, spec p
 }
 
 func (s *PlantService) DeletePlant(ctx context.Context, id uuid.UUID) error {
-	user := s.auth.UserFromContext(ctx)
-	if user == nil {
-		return auth.ErrNotAuthorized
-	}
-	if !user.HasAuthorRights() {
-		return auth.ErrNoAuthorRights
+	_, err := s.auth.Authorize(ctx, auth.PermPlantEdit)
+	if err != nil {
+		return err
 	}
 	return s.plantrepo.Delete(ctx, id)
 }
 
 func (s *PlantService) UploadPlantPhoto(ctx context.Context, id uuid.UUID, fdata models.FileData, description string) error {
-	user := s.auth.UserFromContext(ctx)
-	if user == nil {
-		return auth.ErrNotAuthorized
-	}
-	if !user.HasAuthorRights() {
-		return auth.ErrNoAuthorRights
+	_, err := s.auth.Authorize(ctx, auth.PermPlantEdit)
+	if err != nil {
+		return err
 	}
 	file, err := s.filerepo.Upload(ctx, &fdata)
 	if err != nil {
@@ -88,23 +88,17 @@ func (s *PlantService) UploadPlantPhoto(ctx context.Context, id uuid.UUID, fdata
 }
 
 func (s *PlantService) GetPlantCategory(ctx context.Context, name string) (*plant.PlantCategory, error) {
-	user := s.auth.UserFromContext(ctx)
-	if user == nil {
-		return nil, auth.ErrNotAuthorized
-	}
-	if !user.HasAuthorRights() {
-		return nil, auth.ErrNoAuthorRights
+	_, err := s.auth.Authorize(ctx, auth.PermCategoryManage)
+	if err != nil {
+		return nil, err
 	}
 	return s.categoryrepo.GetCategory(ctx, name)
 }
 
 func (s *PlantService) ListCategories(ctx context.Context) ([]plant.PlantCategory, error) {
-	user := s.auth.UserFromContext(ctx)
-	if user == nil {
-		return nil, auth.ErrNotAuthorized
-	}
-	if !user.HasAuthorRights() {
-		return nil, auth.ErrNoAuthorRights
+	_, err := s.auth.Authorize(ctx, auth.PermCategoryManage)
+	if err != nil {
+		return nil, err
 	}
 	return s.categoryrepo.GetCategories(ctx)
 }
@@ -0,0 +1,64 @@
+package plantservice
+
+import (
+	"PlantSite/internal/models/auth"
+	"PlantSite/internal/models/plant"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// UpdatePlantData holds the plant fields to change, nil fields are left as they are.
+type UpdatePlantData struct {
+	Name        *string
+	LatinName   *string
+	Description *string
+}
+
+func (s *PlantService) UpdatePlant(ctx context.Context, id uuid.UUID, data UpdatePlantData) error {
+	_, err := s.auth.Authorize(ctx, auth.PermPlantEdit)
+	if err != nil {
+		return err
+	}
+	_, err = s.plantrepo.Update(ctx, id, func(p *plant.Plant) (*plant.Plant, error) {
+		if data.Name != nil {
+			if err := p.UpdateName(*data.Name); err != nil {
+				return nil, err
+			}
+		}
+		if data.LatinName != nil {
+			if err := p.UpdateLatinName(*data.LatinName); err != nil {
+				return nil, err
+			}
+		}
+		if data.Description != nil {
+			if err := p.UpdateDescription(*data.Description); err != nil {
+				return nil, err
+			}
+		}
+		return p, nil
+	})
+	if err != nil {
+		return Wrap(err)
+	}
+	return nil
+}
+
+// SetPlantMainPhoto promotes a photo of the plant gallery to its main photo,
+// the former main photo is moved to the gallery.
+func (s *PlantService) SetPlantMainPhoto(ctx context.Context, id uuid.UUID, photoID uuid.UUID) error {
+	_, err := s.auth.Authorize(ctx, auth.PermPlantEdit)
+	if err != nil {
+		return err
+	}
+	_, err = s.plantrepo.Update(ctx, id, func(p *plant.Plant) (*plant.Plant, error) {
+		if err := p.PromotePhoto(photoID); err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+	if err != nil {
+		return Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package plantservice_test
+
+import (
+	"testing"
+
+	"PlantSite/internal/models/auth"
+	"PlantSite/internal/models/plant"
+	authmock "PlantSite/internal/services/auth-service/auth-mock"
+	plantservice "PlantSite/internal/services/plant-service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func plantWithPhotos(t *testing.T, photos ...*plant.PlantPhoto) *plant.Plant {
+	spec := new(MockPlantSpecification)
+	spec.On("Validate").Return(nil)
+	gallery := plant.NewPlantPhotos()
+	for _, photo := range photos {
+		require.NoError(t, gallery.Add(photo))
+	}
+	pl, err := plant.NewPlant("Rose", "Rosa", "Beautiful flower", uuid.New(), *gallery, "mock", spec)
+	require.NoError(t, err)
+	return pl
+}
+
+func TestUpdatePlant(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(uuid.New())
+		ctx, asvc, _ := authmock.Authenticate(user)
+		pl := plantWithPhotos(t)
+		name := "Rose hip"
+		latinName := "Rosa canina"
+
+		prepo := new(MockPlantRepository)
+		prepo.On("Update", mock.Anything, pl.ID(), mock.Anything).Return(pl, nil)
+
+		svc := plantservice.NewPlantService(prepo, new(MockPlantCategoryRepository), new(MockFileRepository), asvc)
+		err := svc.UpdatePlant(ctx, pl.ID(), plantservice.UpdatePlantData{Name: &name, LatinName: &latinName})
+		require.NoError(t, err)
+
+		assert.Equal(t, name, pl.GetName())
+		assert.Equal(t, latinName, pl.GetLatinName())
+		assert.Equal(t, "Beautiful flower", pl.GetDescription())
+	})
+
+	t.Run("EmptyName", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(uuid.New())
+		ctx, asvc, _ := authmock.Authenticate(user)
+		pl := plantWithPhotos(t)
+		name := ""
+
+		prepo := new(MockPlantRepository)
+		prepo.On("Update", mock.Anything, pl.ID(), mock.Anything).Return(pl, nil)
+
+		svc := plantservice.NewPlantService(prepo, new(MockPlantCategoryRepository), new(MockFileRepository), asvc)
+		err := svc.UpdatePlant(ctx, pl.ID(), plantservice.UpdatePlantData{Name: &name})
+		require.Error(t, err)
+		assert.Equal(t, "Rose", pl.GetName())
+	})
+
+	t.Run("NoAuthorRights", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(false)
+		user.On("ID").Return(uuid.New())
+		ctx, asvc, _ := authmock.Authenticate(user)
+		prepo := new(MockPlantRepository)
+
+		svc := plantservice.NewPlantService(prepo, new(MockPlantCategoryRepository), new(MockFileRepository), asvc)
+		err := svc.UpdatePlant(ctx, uuid.New(), plantservice.UpdatePlantData{})
+		require.ErrorIs(t, err, auth.ErrNoAuthorRights)
+		prepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestSetPlantMainPhoto(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(uuid.New())
+		ctx, asvc, _ := authmock.Authenticate(user)
+		photo, err := plant.NewPlantPhoto(uuid.New(), "flowering")
+		require.NoError(t, err)
+		pl := plantWithPhotos(t, photo)
+		formerMainPhotoID := pl.MainPhotoID()
+
+		prepo := new(MockPlantRepository)
+		prepo.On("Update", mock.Anything, pl.ID(), mock.Anything).Return(pl, nil)
+
+		svc := plantservice.NewPlantService(prepo, new(MockPlantCategoryRepository), new(MockFileRepository), asvc)
+		require.NoError(t, svc.SetPlantMainPhoto(ctx, pl.ID(), photo.ID()))
+
+		assert.Equal(t, photo.FileID(), pl.MainPhotoID())
+		var galleryFiles []uuid.UUID
+		_ = pl.GetPhotos().Iterate(func(e plant.PlantPhoto) error {
+			galleryFiles = append(galleryFiles, e.FileID())
+			return nil
+		})
+		assert.Equal(t, []uuid.UUID{formerMainPhotoID}, galleryFiles)
+	})
+
+	t.Run("PhotoNotFound", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(uuid.New())
+		ctx, asvc, _ := authmock.Authenticate(user)
+		pl := plantWithPhotos(t)
+
+		prepo := new(MockPlantRepository)
+		prepo.On("Update", mock.Anything, pl.ID(), mock.Anything).Return(pl, nil)
+
+		svc := plantservice.NewPlantService(prepo, new(MockPlantCategoryRepository), new(MockFileRepository), asvc)
+		err := svc.SetPlantMainPhoto(ctx, pl.ID(), uuid.New())
+		require.ErrorIs(t, err, plant.ErrPlantPhotoNotFound)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"PlantSite/internal/models"
 	"PlantSite/internal/models/auth"
 	"PlantSite/internal/models/post"
+	"PlantSite/internal/models/post/parser"
 	"context"
 )
 
@@ -14,12 +15,9 @@ type CreatePostTextData struct {
 }
 
 func (s *PostService) CreatePost(ctx context.Context, data CreatePostTextData, files []models.FileData) (*post.Post, error) {
-	user := s.auth.UserFromContext(ctx)
-	if user == nil {
-		return nil, auth.ErrNotAuthorized
-	}
-	if !user.HasAuthorRights() {
-		return nil, auth.ErrNoAuthorRights
+	user, err := s.auth.Authorize(ctx, auth.PermPostEdit)
+	if err != nil {
+		return nil, err
 	}
 
 	// photos := make([]post.PostPhoto, 0, len(files))
@@ -43,6 +41,10 @@ func (s *PostService) CreatePost(ctx context.Context, data CreatePostTextData, f
 		}
 	}
 
+	if err := parser.ValidatePhotoPlaces(data.Content.Text, *photos); err != nil {
+		return nil, Wrap(err)
+	}
+
 	post, err := post.NewPost(data.Title, data.Content, data.Tags, user.ID(), photos)
 	if err != nil {
 		return nil, err
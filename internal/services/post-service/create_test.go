@@ -268,4 +268,40 @@ func TestCreatePost(t *testing.T) {
 		assert.Equal(t, validPost, result)
 		assert.Equal(t, 0, result.Photos().Len())
 	})
+
+	t.Run("PhotoMarkerWithoutPhoto", func(t *testing.T) {
+		arepo := new(authmock.MockAuthRepository)
+		sessions := new(authmock.MockSessionStorage)
+		hasher := new(authmock.MockPasswdHasher)
+		asvc := authservice.NewAuthService(sessions, arepo, hasher)
+		validSession := &authservice.Session{
+			ID:        validSessionID,
+			MemberID:  validUserID,
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(validUserID)
+		sessions.On("Get", ctx, validSessionID).Return(validSession, nil)
+		ctx := asvc.Authenticate(ctx, validSessionID)
+		arepo.On("Get", ctx, validUserID).Return(user, nil)
+
+		prepo := new(MockPostRepository)
+		frepo := new(MockFileRepository)
+		for i, file := range validFiles {
+			frepo.On("Upload", mock.Anything, &file).Return(validPhotoFiles[i], nil)
+		}
+
+		content, err := post.NewContent("first \\photo{1}, missing \\photo{3}", post.ContentTypePlainText)
+		require.NoError(t, err)
+		data := validData
+		data.Content = *content
+
+		svc := postservice.NewPostService(prepo, frepo, asvc)
+
+		_, err = svc.CreatePost(ctx, data, validFiles)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, post.ErrPhotoPlaceNotFound)
+		prepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
 }
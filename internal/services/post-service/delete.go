@@ -2,6 +2,8 @@ package postservice
 
 import (
 	"PlantSite/internal/models/auth"
+	"PlantSite/internal/models/post"
+	authservice "PlantSite/internal/services/auth-service"
 	"context"
 	"fmt"
 
@@ -9,15 +11,38 @@ import (
 )
 
 func (s *PostService) Delete(ctx context.Context, id uuid.UUID) error {
-	user := s.auth.UserFromContext(ctx)
-	if user == nil {
-		return auth.ErrNotAuthorized
-	}
-	if !user.HasAuthorRights() {
-		return auth.ErrNoAuthorRights
+	user, err := s.authorizeModify(ctx)
+	if err != nil {
+		return err
 	}
 	if id == uuid.Nil {
 		return fmt.Errorf("nil post")
 	}
+	p, err := s.postRepo.Get(ctx, id)
+	if err != nil {
+		return Wrap(err)
+	}
+	if !canModify(user, p) {
+		return ErrNotAuthor
+	}
 	return s.postRepo.Delete(ctx, id)
 }
+
+// canModify reports whether the user may change or delete the post:
+// only its author can, unless the user moderates posts.
+func canModify(user auth.User, p *post.Post) bool {
+	return user.ID() == p.AuthorID() || authservice.Policy.Can(user, auth.PermPostModerate)
+}
+
+// authorizeModify returns the user of the request if the user may change posts at all:
+// authors change their own posts and moderators change any post.
+func (s *PostService) authorizeModify(ctx context.Context) (auth.User, error) {
+	user := s.auth.UserFromContext(ctx)
+	if authservice.Policy.Can(user, auth.PermPostModerate) {
+		return user, nil
+	}
+	if err := authservice.Policy.Authorize(user, auth.PermPostEdit); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
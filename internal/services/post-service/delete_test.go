@@ -20,7 +20,8 @@ func TestDeletePost(t *testing.T) {
 	validSessionID := uuid.New()
 	validUserID := uuid.New()
 	ctx := context.Background()
-	validPostID := uuid.New()
+	ownPost := postWithPhotos(t, validUserID, "text", 0)
+	validPostID := ownPost.ID()
 
 	t.Run("Success", func(t *testing.T) {
 		arepo := new(authmock.MockAuthRepository)
@@ -34,6 +35,7 @@ func TestDeletePost(t *testing.T) {
 		}
 		user := new(authmock.MockUser)
 		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(validUserID)
 		sessions.On("Get", ctx, validSessionID).Return(validSession, nil)
 		ctx := asvc.Authenticate(ctx, validSessionID)
 		arepo.On("Get", ctx, validUserID).Return(user, nil)
@@ -41,6 +43,7 @@ func TestDeletePost(t *testing.T) {
 		prepo := new(MockPostRepository)
 		frepo := new(MockFileRepository)
 
+		prepo.On("Get", mock.Anything, validPostID).Return(ownPost, nil)
 		prepo.On("Delete", mock.Anything, validPostID).Return(nil)
 
 		svc := postservice.NewPostService(prepo, frepo, asvc)
@@ -110,6 +113,7 @@ func TestDeletePost(t *testing.T) {
 		}
 		user := new(authmock.MockUser)
 		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(validUserID)
 		sessions.On("Get", ctx, validSessionID).Return(validSession, nil)
 		ctx := asvc.Authenticate(ctx, validSessionID)
 		arepo.On("Get", ctx, validUserID).Return(user, nil)
@@ -117,6 +121,7 @@ func TestDeletePost(t *testing.T) {
 		prepo := new(MockPostRepository)
 		frepo := new(MockFileRepository)
 
+		prepo.On("Get", mock.Anything, validPostID).Return(ownPost, nil)
 		prepo.On("Delete", mock.Anything, validPostID).Return(assert.AnError)
 
 		svc := postservice.NewPostService(prepo, frepo, asvc)
@@ -150,4 +155,65 @@ func TestDeletePost(t *testing.T) {
 		err := svc.Delete(ctx, uuid.Nil)
 		require.Error(t, err)
 	})
+
+	t.Run("NotPostAuthor", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(validUserID)
+		ctx, asvc, _ := authmock.Authenticate(user)
+		othersPost := postWithPhotos(t, uuid.New(), "text", 0)
+
+		prepo := new(MockPostRepository)
+		prepo.On("Get", ctx, othersPost.ID()).Return(othersPost, nil)
+
+		svc := postservice.NewPostService(prepo, new(MockFileRepository), asvc)
+
+		err := svc.Delete(ctx, othersPost.ID())
+		require.ErrorIs(t, err, postservice.ErrNotAuthor)
+		prepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("AdminOverride", func(t *testing.T) {
+		admin, err := auth.NewAdmin("admin", []byte("hash"))
+		require.NoError(t, err)
+		ctx, asvc, _ := authmock.Authenticate(admin)
+		othersPost := postWithPhotos(t, uuid.New(), "text", 0)
+
+		prepo := new(MockPostRepository)
+		prepo.On("Get", ctx, othersPost.ID()).Return(othersPost, nil)
+		prepo.On("Delete", ctx, othersPost.ID()).Return(nil)
+
+		svc := postservice.NewPostService(prepo, new(MockFileRepository), asvc)
+
+		require.NoError(t, svc.Delete(ctx, othersPost.ID()))
+		prepo.AssertExpectations(t)
+	})
+
+	t.Run("ModeratorOverride", func(t *testing.T) {
+		arepo := new(authmock.MockAuthRepository)
+		sessions := new(authmock.MockSessionStorage)
+		hasher := new(authmock.MockPasswdHasher)
+		asvc := authservice.NewAuthService(sessions, arepo, hasher)
+		moderator, err := auth.NewMember("moderator", "moderator@example.com", []byte("hash"))
+		require.NoError(t, err)
+		moderator.SetRoles([]string{auth.RoleModerator})
+		validSession := &authservice.Session{
+			ID:        validSessionID,
+			MemberID:  moderator.ID(),
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+		sessions.On("Get", ctx, validSessionID).Return(validSession, nil)
+		ctx := asvc.Authenticate(ctx, validSessionID)
+		arepo.On("Get", ctx, moderator.ID()).Return(moderator, nil)
+
+		othersPost := postWithPhotos(t, uuid.New(), "text", 0)
+		prepo := new(MockPostRepository)
+		prepo.On("Get", ctx, othersPost.ID()).Return(othersPost, nil)
+		prepo.On("Delete", ctx, othersPost.ID()).Return(nil)
+
+		svc := postservice.NewPostService(prepo, new(MockFileRepository), asvc)
+
+		require.NoError(t, svc.Delete(ctx, othersPost.ID()))
+		prepo.AssertExpectations(t)
+	})
 }
@@ -30,12 +30,9 @@ type GetPostPhoto struct {
 }
 
 func (s *PostService) GetPost(ctx context.Context, id uuid.UUID) (*GetPost, error) {
-	user := s.auth.UserFromContext(ctx)
-	if user == nil {
-		return nil, auth.ErrNotAuthorized
-	}
-	if !user.HasAuthorRights() {
-		return nil, auth.ErrNoAuthorRights
+	_, err := s.auth.Authorize(ctx, auth.PermPostEdit)
+	if err != nil {
+		return nil, err
 	}
 	if id == uuid.Nil {
 		return nil, fmt.Errorf("id must be non-nil")
@@ -0,0 +1,172 @@
+package postservice
+
+import (
+	"PlantSite/internal/models"
+	"PlantSite/internal/models/post"
+	"PlantSite/internal/models/post/parser"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// AddPostPhoto uploads a photo and puts it at the given place of the post,
+// shifting the following photos. Non-positive place number appends the photo.
+func (s *PostService) AddPostPhoto(ctx context.Context, postID uuid.UUID, fdata models.FileData, placeNumber int) (*post.PostPhoto, error) {
+	user, err := s.authorizeModify(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if fdata.ContentType != "image/jpeg" && fdata.ContentType != "image/png" {
+		return nil, Wrap(ErrInvalidFileContentType)
+	}
+
+	// Check the post before uploading, so that no file is left behind on obvious errors
+	pst, err := s.postRepo.Get(ctx, postID)
+	if err != nil {
+		return nil, Wrap(err)
+	}
+	if !canModify(user, pst) {
+		return nil, ErrNotAuthor
+	}
+	if pst.Photos().Len() >= post.MaximumPhotoPerPostCount {
+		return nil, Wrap(post.ErrMaximumPhotoCount)
+	}
+
+	file, err := s.fileRepo.Upload(ctx, &fdata)
+	if err != nil {
+		return nil, Wrap(err)
+	}
+
+	var added *post.PostPhoto
+	_, err = s.postRepo.Update(ctx, postID, func(p *post.Post) (*post.Post, error) {
+		if !canModify(user, p) {
+			return nil, ErrNotAuthor
+		}
+		place := placeNumber
+		if place <= 0 || place > p.Photos().Len() {
+			place = p.Photos().Len() + 1
+		}
+		photo, err := post.NewPostPhoto(file.ID, place)
+		if err != nil {
+			return nil, err
+		}
+		before := photoPlaces(p.Photos())
+		if err := p.AddPhoto(photo); err != nil {
+			return nil, err
+		}
+		if err := movePhotoMarkers(p, before); err != nil {
+			return nil, err
+		}
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+		photos := p.Photos()
+		added, err = photos.Get(photo.ID())
+		return p, err
+	})
+	if err != nil {
+		s.deleteOrphanFile(ctx, file.ID)
+		return nil, Wrap(err)
+	}
+	return added, nil
+}
+
+// DeletePostPhoto removes the photo from the post and deletes its file.
+// The post text must not have markers of the removed photo.
+func (s *PostService) DeletePostPhoto(ctx context.Context, postID, photoID uuid.UUID) error {
+	user, err := s.authorizeModify(ctx)
+	if err != nil {
+		return err
+	}
+
+	var fileID uuid.UUID
+	_, err = s.postRepo.Update(ctx, postID, func(p *post.Post) (*post.Post, error) {
+		if !canModify(user, p) {
+			return nil, ErrNotAuthor
+		}
+		photos := p.Photos()
+		photo, err := photos.Get(photoID)
+		if err != nil {
+			return nil, err
+		}
+		fileID = photo.FileID()
+		before := photoPlaces(photos)
+		if err := p.RemovePhoto(photoID); err != nil {
+			return nil, err
+		}
+		if err := movePhotoMarkers(p, before); err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+	if err != nil {
+		return Wrap(err)
+	}
+
+	s.deleteOrphanFile(ctx, fileID)
+	return nil
+}
+
+// ReorderPostPhotos places the post photos in the given order.
+func (s *PostService) ReorderPostPhotos(ctx context.Context, postID uuid.UUID, photoIDs []uuid.UUID) error {
+	user, err := s.authorizeModify(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.postRepo.Update(ctx, postID, func(p *post.Post) (*post.Post, error) {
+		if !canModify(user, p) {
+			return nil, ErrNotAuthor
+		}
+		before := photoPlaces(p.Photos())
+		if err := p.ReorderPhotos(photoIDs); err != nil {
+			return nil, err
+		}
+		if err := movePhotoMarkers(p, before); err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+	if err != nil {
+		return Wrap(err)
+	}
+	return nil
+}
+
+// photoPlaces returns the place numbers of the photos by photo ID.
+func photoPlaces(photos post.PostPhotos) map[uuid.UUID]int {
+	places := make(map[uuid.UUID]int, photos.Len())
+	for _, photo := range photos.List() {
+		places[photo.ID()] = photo.PlaceNumber()
+	}
+	return places
+}
+
+// movePhotoMarkers rewrites the photo markers of the post text, so that they keep
+// pointing to the same photos after the photos left the places given by before.
+func movePhotoMarkers(p *post.Post, before map[uuid.UUID]int) error {
+	moved := make(map[int]int, len(before))
+	for _, photo := range p.Photos().List() {
+		if place, ok := before[photo.ID()]; ok {
+			moved[place] = photo.PlaceNumber()
+		}
+	}
+	content := p.Content()
+	text, err := parser.RenumberPhotoPlaces(content.Text, moved)
+	if err != nil {
+		return err
+	}
+	if text == content.Text {
+		return nil
+	}
+	content.Text = text
+	return p.UpdateContent(content)
+}
+
+// deleteOrphanFile removes a file no post refers to anymore.
+// Failing to do so doesn't fail the request, the file is only wasted space.
+func (s *PostService) deleteOrphanFile(ctx context.Context, fileID uuid.UUID) {
+	if err := s.fileRepo.Delete(ctx, fileID); err != nil {
+		logger.Errorw("Failed to delete orphaned post photo file", "file", fileID, "error", err)
+	}
+}
@@ -0,0 +1,290 @@
+package postservice_test
+
+import (
+	"bytes"
+	"testing"
+
+	"PlantSite/internal/models"
+	"PlantSite/internal/models/post"
+	authmock "PlantSite/internal/services/auth-service/auth-mock"
+	postservice "PlantSite/internal/services/post-service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func postWithPhotos(t *testing.T, authorID uuid.UUID, text string, count int) *post.Post {
+	content, err := post.NewContent(text, post.ContentTypePlainText)
+	require.NoError(t, err)
+	photos := post.NewPostPhotos()
+	for i := 1; i <= count; i++ {
+		photo, err := post.NewPostPhoto(uuid.New(), i)
+		require.NoError(t, err)
+		require.NoError(t, photos.Add(photo))
+	}
+	pst, err := post.NewPost("Test Post", *content, []string{"tag"}, authorID, photos)
+	require.NoError(t, err)
+	return pst
+}
+
+func TestAddPostPhoto(t *testing.T) {
+	authorID := uuid.New()
+	fdata := models.FileData{Name: "photo.jpg", ContentType: "image/jpeg", Reader: bytes.NewReader([]byte("image data"))}
+	updateFn := mock.AnythingOfType("func(*post.Post) (*post.Post, error)")
+
+	t.Run("Success", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(authorID)
+		ctx, asvc, _ := authmock.Authenticate(user)
+		pst := postWithPhotos(t, authorID, "text", 2)
+		file := &models.File{ID: uuid.New()}
+
+		prepo := new(MockPostRepository)
+		frepo := new(MockFileRepository)
+		prepo.On("Get", ctx, pst.ID()).Return(pst, nil)
+		frepo.On("Upload", ctx, &fdata).Return(file, nil)
+		prepo.On("Update", ctx, pst.ID(), updateFn).Return(pst, nil)
+
+		svc := postservice.NewPostService(prepo, frepo, asvc)
+		photo, err := svc.AddPostPhoto(ctx, pst.ID(), fdata, 1)
+		require.NoError(t, err)
+		assert.Equal(t, file.ID, photo.FileID())
+		assert.Equal(t, 1, photo.PlaceNumber())
+		assert.Equal(t, 3, pst.Photos().Len())
+		frepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("MarkersFollowPhotos", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(authorID)
+		ctx, asvc, _ := authmock.Authenticate(user)
+		pst := postWithPhotos(t, authorID, "text \\photo{1} \\photo{2}", 2)
+		file := &models.File{ID: uuid.New()}
+
+		prepo := new(MockPostRepository)
+		frepo := new(MockFileRepository)
+		prepo.On("Get", ctx, pst.ID()).Return(pst, nil)
+		frepo.On("Upload", ctx, &fdata).Return(file, nil)
+		prepo.On("Update", ctx, pst.ID(), updateFn).Return(pst, nil)
+
+		svc := postservice.NewPostService(prepo, frepo, asvc)
+		_, err := svc.AddPostPhoto(ctx, pst.ID(), fdata, 1)
+		require.NoError(t, err)
+		assert.Equal(t, "text \\photo{2} \\photo{3}", pst.Content().Text)
+	})
+
+	t.Run("MaximumPhotoCount", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(authorID)
+		ctx, asvc, _ := authmock.Authenticate(user)
+		pst := postWithPhotos(t, authorID, "text", post.MaximumPhotoPerPostCount)
+
+		prepo := new(MockPostRepository)
+		frepo := new(MockFileRepository)
+		prepo.On("Get", ctx, pst.ID()).Return(pst, nil)
+
+		svc := postservice.NewPostService(prepo, frepo, asvc)
+		_, err := svc.AddPostPhoto(ctx, pst.ID(), fdata, 0)
+		require.ErrorIs(t, err, post.ErrMaximumPhotoCount)
+		frepo.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything)
+	})
+
+	t.Run("NotAuthor", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(authorID)
+		ctx, asvc, _ := authmock.Authenticate(user)
+		pst := postWithPhotos(t, uuid.New(), "text", 1)
+
+		prepo := new(MockPostRepository)
+		frepo := new(MockFileRepository)
+		prepo.On("Get", ctx, pst.ID()).Return(pst, nil)
+
+		svc := postservice.NewPostService(prepo, frepo, asvc)
+		_, err := svc.AddPostPhoto(ctx, pst.ID(), fdata, 0)
+		require.ErrorIs(t, err, postservice.ErrNotAuthor)
+		frepo.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything)
+	})
+
+	t.Run("CleanupOnUpdateError", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(authorID)
+		ctx, asvc, _ := authmock.Authenticate(user)
+		pst := postWithPhotos(t, authorID, "text", 1)
+		file := &models.File{ID: uuid.New()}
+
+		prepo := new(MockPostRepository)
+		frepo := new(MockFileRepository)
+		prepo.On("Get", ctx, pst.ID()).Return(pst, nil)
+		frepo.On("Upload", ctx, &fdata).Return(file, nil)
+		prepo.On("Update", ctx, pst.ID(), updateFn).Return(nil, assert.AnError)
+		frepo.On("Delete", ctx, file.ID).Return(nil)
+
+		svc := postservice.NewPostService(prepo, frepo, asvc)
+		_, err := svc.AddPostPhoto(ctx, pst.ID(), fdata, 0)
+		require.ErrorIs(t, err, assert.AnError)
+		frepo.AssertExpectations(t)
+	})
+
+	t.Run("InvalidContentType", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(authorID)
+		ctx, asvc, _ := authmock.Authenticate(user)
+		svc := postservice.NewPostService(new(MockPostRepository), new(MockFileRepository), asvc)
+		_, err := svc.AddPostPhoto(ctx, uuid.New(), models.FileData{ContentType: "text/plain"}, 0)
+		require.ErrorIs(t, err, postservice.ErrInvalidFileContentType)
+	})
+}
+
+func TestDeletePostPhoto(t *testing.T) {
+	authorID := uuid.New()
+	updateFn := mock.AnythingOfType("func(*post.Post) (*post.Post, error)")
+
+	t.Run("Success", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(authorID)
+		ctx, asvc, _ := authmock.Authenticate(user)
+		pst := postWithPhotos(t, authorID, "text \\photo{1}", 2)
+		removed := pst.Photos().List()[1]
+
+		prepo := new(MockPostRepository)
+		frepo := new(MockFileRepository)
+		prepo.On("Update", ctx, pst.ID(), updateFn).Return(pst, nil)
+		frepo.On("Delete", ctx, removed.FileID()).Return(nil)
+
+		svc := postservice.NewPostService(prepo, frepo, asvc)
+		require.NoError(t, svc.DeletePostPhoto(ctx, pst.ID(), removed.ID()))
+		assert.Equal(t, 1, pst.Photos().Len())
+		frepo.AssertExpectations(t)
+	})
+
+	t.Run("MarkersFollowPhotos", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(authorID)
+		ctx, asvc, _ := authmock.Authenticate(user)
+		pst := postWithPhotos(t, authorID, "text \\photo{3}", 3)
+		removed := pst.Photos().List()[0]
+
+		prepo := new(MockPostRepository)
+		frepo := new(MockFileRepository)
+		prepo.On("Update", ctx, pst.ID(), updateFn).Return(pst, nil)
+		frepo.On("Delete", ctx, removed.FileID()).Return(nil)
+
+		svc := postservice.NewPostService(prepo, frepo, asvc)
+		require.NoError(t, svc.DeletePostPhoto(ctx, pst.ID(), removed.ID()))
+		assert.Equal(t, "text \\photo{2}", pst.Content().Text)
+	})
+
+	t.Run("ShiftedPhotoReferenced", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(authorID)
+		ctx, asvc, _ := authmock.Authenticate(user)
+		pst := postWithPhotos(t, authorID, "text \\photo{1}", 2)
+		removed := pst.Photos().List()[0]
+
+		prepo := new(MockPostRepository)
+		frepo := new(MockFileRepository)
+		prepo.On("Update", ctx, pst.ID(), updateFn).Return(pst, nil)
+
+		svc := postservice.NewPostService(prepo, frepo, asvc)
+		err := svc.DeletePostPhoto(ctx, pst.ID(), removed.ID())
+		require.ErrorIs(t, err, post.ErrPhotoPlaceNotFound)
+		frepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("PhotoStillReferenced", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(authorID)
+		ctx, asvc, _ := authmock.Authenticate(user)
+		pst := postWithPhotos(t, authorID, "text \\photo{2}", 2)
+		removed := pst.Photos().List()[1]
+
+		prepo := new(MockPostRepository)
+		frepo := new(MockFileRepository)
+		prepo.On("Update", ctx, pst.ID(), updateFn).Return(pst, nil)
+
+		svc := postservice.NewPostService(prepo, frepo, asvc)
+		err := svc.DeletePostPhoto(ctx, pst.ID(), removed.ID())
+		require.ErrorIs(t, err, post.ErrPhotoPlaceNotFound)
+		frepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("PhotoNotFound", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(authorID)
+		ctx, asvc, _ := authmock.Authenticate(user)
+		pst := postWithPhotos(t, authorID, "text", 1)
+
+		prepo := new(MockPostRepository)
+		frepo := new(MockFileRepository)
+		prepo.On("Update", ctx, pst.ID(), updateFn).Return(pst, nil)
+
+		svc := postservice.NewPostService(prepo, frepo, asvc)
+		err := svc.DeletePostPhoto(ctx, pst.ID(), uuid.New())
+		require.ErrorIs(t, err, post.ErrPostPhotoNotFound)
+	})
+}
+
+func TestReorderPostPhotos(t *testing.T) {
+	authorID := uuid.New()
+	updateFn := mock.AnythingOfType("func(*post.Post) (*post.Post, error)")
+
+	t.Run("Success", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(authorID)
+		ctx, asvc, _ := authmock.Authenticate(user)
+		pst := postWithPhotos(t, authorID, "text", 2)
+		list := pst.Photos().List()
+
+		prepo := new(MockPostRepository)
+		prepo.On("Update", ctx, pst.ID(), updateFn).Return(pst, nil)
+
+		svc := postservice.NewPostService(prepo, new(MockFileRepository), asvc)
+		require.NoError(t, svc.ReorderPostPhotos(ctx, pst.ID(), []uuid.UUID{list[1].ID(), list[0].ID()}))
+		assert.Equal(t, list[1].ID(), pst.Photos().List()[0].ID())
+	})
+
+	t.Run("MarkersFollowPhotos", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(authorID)
+		ctx, asvc, _ := authmock.Authenticate(user)
+		pst := postWithPhotos(t, authorID, "text \\photo{1}", 2)
+		list := pst.Photos().List()
+
+		prepo := new(MockPostRepository)
+		prepo.On("Update", ctx, pst.ID(), updateFn).Return(pst, nil)
+
+		svc := postservice.NewPostService(prepo, new(MockFileRepository), asvc)
+		require.NoError(t, svc.ReorderPostPhotos(ctx, pst.ID(), []uuid.UUID{list[1].ID(), list[0].ID()}))
+		assert.Equal(t, "text \\photo{2}", pst.Content().Text)
+	})
+
+	t.Run("InvalidOrder", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(authorID)
+		ctx, asvc, _ := authmock.Authenticate(user)
+		pst := postWithPhotos(t, authorID, "text", 2)
+
+		prepo := new(MockPostRepository)
+		prepo.On("Update", ctx, pst.ID(), updateFn).Return(pst, nil)
+
+		svc := postservice.NewPostService(prepo, new(MockFileRepository), asvc)
+		err := svc.ReorderPostPhotos(ctx, pst.ID(), []uuid.UUID{uuid.New()})
+		require.ErrorIs(t, err, post.ErrInvalidPhotoOrder)
+	})
+}
@@ -4,8 +4,18 @@ import (
 	"PlantSite/internal/models"
 	"PlantSite/internal/models/post"
 	authservice "PlantSite/internal/services/auth-service"
+
+	"go.uber.org/zap"
 )
 
+// logger reports failures that don't fail the request, such as files left behind.
+var logger = zap.NewNop().Sugar()
+
+// UpdateLogger sets the logger of the post service.
+func UpdateLogger(l *zap.SugaredLogger) {
+	logger = l
+}
+
 type PostService struct {
 	postRepo post.PostRepository
 	fileRepo models.FileRepository
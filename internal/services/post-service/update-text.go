@@ -1,8 +1,8 @@
 package postservice
 
 import (
-	"PlantSite/internal/models/auth"
 	"PlantSite/internal/models/post"
+	"PlantSite/internal/models/post/parser"
 	"context"
 
 	"github.com/google/uuid"
@@ -15,19 +15,20 @@ type UpdatePostTextData struct {
 }
 
 func (s *PostService) UpdatePost(ctx context.Context, id uuid.UUID, data UpdatePostTextData) (*post.Post, error) {
-	user := s.auth.UserFromContext(ctx)
-	if user == nil {
-		return nil, auth.ErrNotAuthorized
-	}
-	if !user.HasAuthorRights() {
-		return nil, auth.ErrNoAuthorRights
+	user, err := s.authorizeModify(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	p, err := s.postRepo.Update(ctx, id, func(p *post.Post) (*post.Post, error) {
-		if user.ID() != p.AuthorID() {
+		if !canModify(user, p) {
 			return nil, ErrNotAuthor
 		}
-		err := p.UpdateContent(data.Content)
+		err := parser.ValidatePhotoPlaces(data.Content.Text, p.Photos())
+		if err != nil {
+			return nil, Wrap(err)
+		}
+		err = p.UpdateContent(data.Content)
 		if err != nil {
 			return nil, err
 		}
@@ -278,3 +278,74 @@ func TestUpdatePost(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestUpdatePostModeration(t *testing.T) {
+	content, err := post.NewContent("moderated content", post.ContentTypePlainText)
+	require.NoError(t, err)
+	data := postservice.UpdatePostTextData{
+		Title:   "Moderated",
+		Content: *content,
+		Tags:    []string{"tag"},
+	}
+	updateFn := mock.AnythingOfType("func(*post.Post) (*post.Post, error)")
+
+	t.Run("NotPostAuthor", func(t *testing.T) {
+		user := new(authmock.MockUser)
+		user.On("HasAuthorRights").Return(true)
+		user.On("ID").Return(uuid.New())
+		ctx, asvc, _ := authmock.Authenticate(user)
+		othersPost := postWithPhotos(t, uuid.New(), "text", 0)
+
+		prepo := new(MockPostRepository)
+		prepo.On("Update", ctx, othersPost.ID(), updateFn).Return(othersPost, nil)
+
+		svc := postservice.NewPostService(prepo, new(MockFileRepository), asvc)
+		_, err := svc.UpdatePost(ctx, othersPost.ID(), data)
+		require.ErrorIs(t, err, postservice.ErrNotAuthor)
+		assert.Equal(t, "Test Post", othersPost.Title())
+	})
+
+	t.Run("AdminOverride", func(t *testing.T) {
+		admin, err := auth.NewAdmin("admin", []byte("hash"))
+		require.NoError(t, err)
+		ctx, asvc, _ := authmock.Authenticate(admin)
+		othersPost := postWithPhotos(t, uuid.New(), "text", 0)
+
+		prepo := new(MockPostRepository)
+		prepo.On("Update", ctx, othersPost.ID(), updateFn).Return(othersPost, nil)
+
+		svc := postservice.NewPostService(prepo, new(MockFileRepository), asvc)
+		result, err := svc.UpdatePost(ctx, othersPost.ID(), data)
+		require.NoError(t, err)
+		assert.Equal(t, "Moderated", result.Title())
+	})
+
+	t.Run("ModeratorOverride", func(t *testing.T) {
+		ctx := context.Background()
+		sessionID := uuid.New()
+		arepo := new(authmock.MockAuthRepository)
+		sessions := new(authmock.MockSessionStorage)
+		hasher := new(authmock.MockPasswdHasher)
+		asvc := authservice.NewAuthService(sessions, arepo, hasher)
+		moderator, err := auth.NewMember("moderator", "moderator@example.com", []byte("hash"))
+		require.NoError(t, err)
+		moderator.SetRoles([]string{auth.RoleModerator})
+		session := &authservice.Session{
+			ID:        sessionID,
+			MemberID:  moderator.ID(),
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+		sessions.On("Get", ctx, sessionID).Return(session, nil)
+		ctx = asvc.Authenticate(ctx, sessionID)
+		arepo.On("Get", ctx, moderator.ID()).Return(moderator, nil)
+
+		othersPost := postWithPhotos(t, uuid.New(), "text", 0)
+		prepo := new(MockPostRepository)
+		prepo.On("Update", ctx, othersPost.ID(), updateFn).Return(othersPost, nil)
+
+		svc := postservice.NewPostService(prepo, new(MockFileRepository), asvc)
+		result, err := svc.UpdatePost(ctx, othersPost.ID(), data)
+		require.NoError(t, err)
+		assert.Equal(t, "Moderated", result.Title())
+	})
+}
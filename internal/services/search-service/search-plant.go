@@ -45,3 +45,11 @@ func (s *SearchService) SearchPlants(ctx context.Context, plSearch *search.Plant
 	}
 	return searchPlants, nil
 }
+
+func (s *SearchService) CountPlants(ctx context.Context, plSearch *search.PlantSearch) (int, error) {
+	count, err := s.searchRepo.CountPlants(ctx, plSearch)
+	if err != nil {
+		return 0, Wrap(err)
+	}
+	return count, nil
+}
@@ -193,3 +193,77 @@ func TestSearchPlants(t *testing.T) {
 		assert.ErrorIs(t, err, assert.AnError)
 	})
 }
+
+func TestCountPlants(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		srepo := new(MockSearchRepository)
+		pfrepo := new(MockFileRepository)
+		ptfrepo := new(MockFileRepository)
+
+		searchQuery := search.NewPlantSearch()
+		srepo.On("CountPlants", ctx, searchQuery).Return(42, nil)
+
+		svc := searchservice.NewSearchService(srepo, pfrepo, ptfrepo)
+
+		count, err := svc.CountPlants(ctx, searchQuery)
+		require.NoError(t, err)
+		assert.Equal(t, 42, count)
+		srepo.AssertExpectations(t)
+	})
+
+	t.Run("RepositoryError", func(t *testing.T) {
+		srepo := new(MockSearchRepository)
+		pfrepo := new(MockFileRepository)
+		ptfrepo := new(MockFileRepository)
+
+		searchQuery := search.NewPlantSearch()
+		srepo.On("CountPlants", ctx, searchQuery).Return(0, assert.AnError)
+
+		svc := searchservice.NewSearchService(srepo, pfrepo, ptfrepo)
+
+		_, err := svc.CountPlants(ctx, searchQuery)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestSuggestPlants(t *testing.T) {
+	ctx := context.Background()
+
+	spec, err := plant.NewConiferousSpecification(10.5, 2.3, 5, plant.MediumMoisture, plant.HalfShadow, plant.MediumSoil, 6)
+	require.NoError(t, err)
+	pine, err := mockPlant("Pine", "Pinus sylvestris", "coniferous", spec)
+	require.NoError(t, err)
+
+	t.Run("Success", func(t *testing.T) {
+		srepo := new(MockSearchRepository)
+		pfrepo := new(MockFileRepository)
+		ptfrepo := new(MockFileRepository)
+
+		expected := search.NewPlantSearch()
+		expected.AddFilter(search.NewPlantSimilarNameFilter("pinus silvestris"))
+		require.NoError(t, expected.SetSort(search.Sort{Key: search.SortByRelevance}))
+		expected.SetPage(search.Page{Limit: 5})
+		srepo.On("SearchPlants", ctx, expected).Return([]*plant.Plant{pine}, nil)
+
+		svc := searchservice.NewSearchService(srepo, pfrepo, ptfrepo)
+
+		suggestions, err := svc.SuggestPlants(ctx, "pinus silvestris", 5)
+		require.NoError(t, err)
+		require.Len(t, suggestions, 1)
+		assert.Equal(t, pine.ID(), suggestions[0].ID)
+		assert.Equal(t, "Pine", suggestions[0].Name)
+		assert.Equal(t, "Pinus sylvestris", suggestions[0].LatinName)
+		srepo.AssertExpectations(t)
+	})
+
+	t.Run("InvalidLimit", func(t *testing.T) {
+		svc := searchservice.NewSearchService(new(MockSearchRepository), new(MockFileRepository), new(MockFileRepository))
+
+		_, err := svc.SuggestPlants(ctx, "pine", -1)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, search.ErrInvalidPage)
+	})
+}
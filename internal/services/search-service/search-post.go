@@ -61,3 +61,11 @@ func (s *SearchService) SearchPosts(ctx context.Context, plSearch *search.PostSe
 	}
 	return searchPosts, nil
 }
+
+func (s *SearchService) CountPosts(ctx context.Context, plSearch *search.PostSearch) (int, error) {
+	count, err := s.searchRepo.CountPosts(ctx, plSearch)
+	if err != nil {
+		return 0, Wrap(err)
+	}
+	return count, nil
+}
@@ -50,6 +50,16 @@ func (m *MockSearchRepository) SearchPlants(ctx context.Context, search *search.
 	return res, args.Error(1)
 }
 
+func (m *MockSearchRepository) CountPosts(ctx context.Context, search *search.PostSearch) (int, error) {
+	args := m.Called(ctx, search)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockSearchRepository) CountPlants(ctx context.Context, search *search.PlantSearch) (int, error) {
+	args := m.Called(ctx, search)
+	return args.Int(0), args.Error(1)
+}
+
 func (m *MockSearchRepository) GetPostByID(ctx context.Context, id uuid.UUID) (*post.Post, error) {
 	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
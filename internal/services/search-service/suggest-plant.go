@@ -0,0 +1,43 @@
+package searchservice
+
+import (
+	"PlantSite/internal/models/search"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type PlantSuggestion struct {
+	ID        uuid.UUID
+	Name      string
+	LatinName string
+}
+
+// SuggestPlants returns at most limit plants with name or latin name similar to the given one,
+// the closest first. It is used for plant name autocompletion.
+func (s *SearchService) SuggestPlants(ctx context.Context, name string, limit int) ([]*PlantSuggestion, error) {
+	srch := search.NewPlantSearch()
+	srch.AddFilter(search.NewPlantSimilarNameFilter(name))
+	if err := srch.SetSort(search.Sort{Key: search.SortByRelevance}); err != nil {
+		return nil, Wrap(err)
+	}
+	page, err := search.NewPage(limit, 0)
+	if err != nil {
+		return nil, Wrap(err)
+	}
+	srch.SetPage(page)
+
+	plants, err := s.searchRepo.SearchPlants(ctx, srch)
+	if err != nil {
+		return nil, Wrap(err)
+	}
+	suggestions := make([]*PlantSuggestion, 0, len(plants))
+	for _, p := range plants {
+		suggestions = append(suggestions, &PlantSuggestion{
+			ID:        p.ID(),
+			Name:      p.GetName(),
+			LatinName: p.GetLatinName(),
+		})
+	}
+	return suggestions, nil
+}
@@ -0,0 +1,132 @@
+package argon2hasher
+
+import (
+	authservice "PlantSite/internal/services/auth-service"
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// prefix starts every hash, hashes are stored in the PHC string format:
+// $argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>
+const prefix = "$argon2id$"
+
+var (
+	ErrInvalidHash               = errors.New("argon2id: invalid hash")
+	ErrIncompatibleVersion       = errors.New("argon2id: incompatible version")
+	ErrMismatchedHashAndPassword = errors.New("argon2id: hashedPassword is not the hash of the given password")
+)
+
+// Params are the cost parameters of argon2id, memory is in KiB.
+type Params struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// DefaultParams follow the OWASP recommendation for argon2id.
+var DefaultParams = Params{
+	Memory:      64 * 1024,
+	Iterations:  3,
+	Parallelism: 2,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+type argon2hasher struct {
+	params Params
+}
+
+func NewArgon2Hasher(params Params) authservice.PasswdHasher {
+	if params.Memory < 8*uint32(params.Parallelism) {
+		panic("argon2 memory must be at least 8 KiB per thread")
+	}
+	if params.Iterations < 1 {
+		panic("argon2 iterations must be greater than 0")
+	}
+	if params.Parallelism < 1 {
+		panic("argon2 parallelism must be greater than 0")
+	}
+	if params.SaltLength < 8 || params.KeyLength < 16 {
+		panic("argon2 salt or key too short")
+	}
+	return &argon2hasher{params: params}
+}
+
+func (a *argon2hasher) Hash(passwd []byte) ([]byte, error) {
+	salt := make([]byte, a.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	key := argon2.IDKey(passwd, salt, a.params.Iterations, a.params.Memory, a.params.Parallelism, a.params.KeyLength)
+
+	return fmt.Appendf(nil, "%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		prefix, argon2.Version, a.params.Memory, a.params.Iterations, a.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (a *argon2hasher) Compare(hashedPasswd, plainPasswd []byte) (bool, error) {
+	params, salt, key, err := decode(hashedPasswd)
+	if err != nil {
+		return false, err
+	}
+	other := argon2.IDKey(plainPasswd, salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+	if subtle.ConstantTimeCompare(key, other) != 1 {
+		return false, ErrMismatchedHashAndPassword
+	}
+	return true, nil
+}
+
+// Matches reports whether the hash was made by argon2id.
+func (a *argon2hasher) Matches(hashedPasswd []byte) bool {
+	return bytes.HasPrefix(hashedPasswd, []byte(prefix))
+}
+
+// NeedsRehash reports whether the hash was made with other parameters than the current ones.
+func (a *argon2hasher) NeedsRehash(hashedPasswd []byte) bool {
+	params, _, _, err := decode(hashedPasswd)
+	return err != nil || params != a.params
+}
+
+func decode(hashedPasswd []byte) (Params, []byte, []byte, error) {
+	var params Params
+	parts := strings.Split(string(hashedPasswd), "$")
+	if len(parts) != 6 || "$"+parts[1]+"$" != prefix {
+		return params, nil, nil, ErrInvalidHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return params, nil, nil, ErrInvalidHash
+	}
+	if version != argon2.Version {
+		return params, nil, nil, ErrIncompatibleVersion
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return params, nil, nil, ErrInvalidHash
+	}
+	if params.Iterations < 1 || params.Parallelism < 1 {
+		return params, nil, nil, ErrInvalidHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, ErrInvalidHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return params, nil, nil, ErrInvalidHash
+	}
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+	return params, salt, key, nil
+}
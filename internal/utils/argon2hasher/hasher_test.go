@@ -0,0 +1,83 @@
+package argon2hasher
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testParams keep the tests fast
+var testParams = Params{
+	Memory:      64,
+	Iterations:  1,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+func TestNewArgon2Hasher(t *testing.T) {
+	t.Run("valid params", func(t *testing.T) {
+		assert.NotNil(t, NewArgon2Hasher(DefaultParams))
+	})
+
+	t.Run("panic when memory too low", func(t *testing.T) {
+		params := testParams
+		params.Memory = 4
+		assert.Panics(t, func() { NewArgon2Hasher(params) })
+	})
+
+	t.Run("panic when no iterations", func(t *testing.T) {
+		params := testParams
+		params.Iterations = 0
+		assert.Panics(t, func() { NewArgon2Hasher(params) })
+	})
+}
+
+func TestArgon2Hasher_Compare(t *testing.T) {
+	hasher := NewArgon2Hasher(testParams).(*argon2hasher)
+	password := []byte("test_password")
+	hashed, err := hasher.Hash(password)
+	require.NoError(t, err)
+
+	t.Run("format", func(t *testing.T) {
+		assert.Regexp(t, `^\$argon2id\$v=19\$m=64,t=1,p=1\$[A-Za-z0-9+/]+\$[A-Za-z0-9+/]+$`, string(hashed))
+		assert.True(t, hasher.Matches(hashed))
+	})
+
+	t.Run("salted", func(t *testing.T) {
+		other, err := hasher.Hash(password)
+		require.NoError(t, err)
+		assert.NotEqual(t, hashed, other)
+	})
+
+	t.Run("successful compare", func(t *testing.T) {
+		match, err := hasher.Compare(hashed, password)
+		require.NoError(t, err)
+		assert.True(t, match)
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		match, err := hasher.Compare(hashed, []byte("wrong_password"))
+		require.ErrorIs(t, err, ErrMismatchedHashAndPassword)
+		assert.False(t, match)
+	})
+
+	t.Run("invalid hash", func(t *testing.T) {
+		match, err := hasher.Compare([]byte("$2a$10$invalid"), password)
+		require.ErrorIs(t, err, ErrInvalidHash)
+		assert.False(t, match)
+	})
+
+	t.Run("hash of other params", func(t *testing.T) {
+		params := testParams
+		params.Iterations = 2
+		stronger := NewArgon2Hasher(params).(*argon2hasher)
+
+		match, err := stronger.Compare(hashed, password)
+		require.NoError(t, err)
+		assert.True(t, match)
+		assert.True(t, stronger.NeedsRehash(hashed))
+		assert.False(t, hasher.NeedsRehash(hashed))
+	})
+}
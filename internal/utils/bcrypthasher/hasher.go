@@ -2,6 +2,7 @@ package bcrypthasher
 
 import (
 	authservice "PlantSite/internal/services/auth-service"
+	"bytes"
 
 	"golang.org/x/crypto/bcrypt"
 )
@@ -14,7 +15,7 @@ func NewBcryptHasher(cost int) authservice.PasswdHasher {
 	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
 		panic("bcrypt cost out of range")
 	}
-	return &bcrypthasher{}
+	return &bcrypthasher{cost: cost}
 }
 
 func (b *bcrypthasher) Hash(passwd []byte) ([]byte, error) {
@@ -26,3 +27,19 @@ func (b *bcrypthasher) Compare(hashedPasswd, plainPasswd []byte) (bool, error) {
 	err := bcrypt.CompareHashAndPassword(hashedPasswd, plainPasswd)
 	return err == nil, err
 }
+
+// Matches reports whether the hash was made by bcrypt.
+func (b *bcrypthasher) Matches(hashedPasswd []byte) bool {
+	for _, prefix := range []string{"$2a$", "$2b$", "$2y$"} {
+		if bytes.HasPrefix(hashedPasswd, []byte(prefix)) {
+			return true
+		}
+	}
+	return false
+}
+
+// NeedsRehash reports whether the hash was made with another cost than the current one.
+func (b *bcrypthasher) NeedsRehash(hashedPasswd []byte) bool {
+	cost, err := bcrypt.Cost(hashedPasswd)
+	return err != nil || cost != b.cost
+}
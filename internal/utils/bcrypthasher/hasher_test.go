@@ -84,3 +84,19 @@ func TestBcryptHasher_Compare(t *testing.T) {
 		assert.False(t, match)
 	})
 }
+
+func TestBcryptHasher_NeedsRehash(t *testing.T) {
+	hasher := NewBcryptHasher(bcrypt.MinCost).(*bcrypthasher)
+	hashed, err := hasher.Hash([]byte("test_password"))
+	require.NoError(t, err)
+
+	cost, err := bcrypt.Cost(hashed)
+	require.NoError(t, err)
+	assert.Equal(t, bcrypt.MinCost, cost)
+	assert.True(t, hasher.Matches(hashed))
+	assert.False(t, hasher.NeedsRehash(hashed))
+
+	stronger := NewBcryptHasher(bcrypt.MinCost + 1).(*bcrypthasher)
+	assert.True(t, stronger.NeedsRehash(hashed))
+	assert.False(t, hasher.Matches([]byte("$argon2id$v=19$m=65536,t=3,p=2$c2FsdA$a2V5")))
+}
@@ -0,0 +1,293 @@
+package markdown
+
+import (
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// PlantScheme is the link scheme of plant references, e.g. [rose](plant:id).
+	PlantScheme = "plant:"
+	// PhotoMarker places a post photo inline, e.g. \photo{2}.
+	PhotoMarker = "\\photo{"
+)
+
+type Options struct {
+	// PlantLink returns the page of a referenced plant.
+	// Links to unknown plants are rendered as plain text.
+	PlantLink func(ref string) (string, bool)
+	// PhotoURL returns the url of a post photo by its place number.
+	// Markers of unknown photos are dropped.
+	PhotoURL func(place int) (string, bool)
+}
+
+var (
+	headingPattern     = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	rulePattern        = regexp.MustCompile(`^\s*([-*_])(\s*[-*_]){2,}\s*$`)
+	unorderedPattern   = regexp.MustCompile(`^\s*[-*+]\s+(.*)$`)
+	orderedPattern     = regexp.MustCompile(`^\s*\d+[.)]\s+(.*)$`)
+	quotePattern       = regexp.MustCompile(`^\s*>\s?(.*)$`)
+	fencePattern       = regexp.MustCompile("^\\s*```")
+	safeSchemePattern  = regexp.MustCompile(`(?i)^(https?|mailto):`)
+	anySchemePattern   = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*:`)
+	escapableCharacter = "\\`*_{}[]()#+-.!>~|"
+)
+
+// Render converts markdown to html. Any html in the text is escaped
+// and only http(s), mailto, relative and plant links are kept,
+// so the result is safe to embed into a page.
+//
+// Supported: headings, paragraphs, emphasis, inline and fenced code,
+// links, ordered and unordered lists, block quotes and horizontal rules.
+func Render(text string, opts Options) string {
+	r := &renderer{opts: opts}
+	r.render(strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n"))
+	return r.out.String()
+}
+
+type renderer struct {
+	opts Options
+	out  strings.Builder
+
+	paragraph []string
+	quote     []string
+	list      string // "ul", "ol" or "" if no list is open
+}
+
+func (r *renderer) render(lines []string) {
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		if fencePattern.MatchString(line) {
+			r.closeBlocks()
+			code := make([]string, 0)
+			for i++; i < len(lines) && !fencePattern.MatchString(lines[i]); i++ {
+				code = append(code, lines[i])
+			}
+			r.out.WriteString("<pre><code>")
+			r.out.WriteString(html.EscapeString(strings.Join(code, "\n")))
+			r.out.WriteString("</code></pre>\n")
+			continue
+		}
+
+		if strings.TrimSpace(line) == "" {
+			r.closeBlocks()
+			continue
+		}
+
+		if m := quotePattern.FindStringSubmatch(line); m != nil {
+			r.closeParagraph()
+			r.closeList()
+			r.quote = append(r.quote, m[1])
+			continue
+		}
+		r.closeQuote()
+
+		if m := headingPattern.FindStringSubmatch(line); m != nil {
+			r.closeBlocks()
+			tag := "h" + string(rune('0'+len(m[1])))
+			r.out.WriteString("<" + tag + ">" + r.inline(m[2]) + "</" + tag + ">\n")
+			continue
+		}
+
+		if rulePattern.MatchString(line) {
+			r.closeBlocks()
+			r.out.WriteString("<hr>\n")
+			continue
+		}
+
+		if m := unorderedPattern.FindStringSubmatch(line); m != nil {
+			r.listItem("ul", m[1])
+			continue
+		}
+		if m := orderedPattern.FindStringSubmatch(line); m != nil {
+			r.listItem("ol", m[1])
+			continue
+		}
+
+		r.closeList()
+		r.paragraph = append(r.paragraph, strings.TrimSpace(line))
+	}
+	r.closeBlocks()
+}
+
+func (r *renderer) listItem(tag, text string) {
+	r.closeParagraph()
+	if r.list != tag {
+		r.closeList()
+		r.out.WriteString("<" + tag + ">\n")
+		r.list = tag
+	}
+	r.out.WriteString("<li>" + r.inline(text) + "</li>\n")
+}
+
+func (r *renderer) closeBlocks() {
+	r.closeParagraph()
+	r.closeList()
+	r.closeQuote()
+}
+
+func (r *renderer) closeParagraph() {
+	if len(r.paragraph) == 0 {
+		return
+	}
+	r.out.WriteString("<p>" + r.inline(strings.Join(r.paragraph, "\n")) + "</p>\n")
+	r.paragraph = nil
+}
+
+func (r *renderer) closeList() {
+	if r.list == "" {
+		return
+	}
+	r.out.WriteString("</" + r.list + ">\n")
+	r.list = ""
+}
+
+func (r *renderer) closeQuote() {
+	if len(r.quote) == 0 {
+		return
+	}
+	inner := &renderer{opts: r.opts}
+	inner.render(r.quote)
+	r.quote = nil
+	r.out.WriteString("<blockquote>\n" + inner.out.String() + "</blockquote>\n")
+}
+
+// inline renders emphasis, code spans and links, escaping everything else.
+func (r *renderer) inline(text string) string {
+	var out strings.Builder
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == '\\' && strings.HasPrefix(text[i:], PhotoMarker):
+			if end := strings.IndexByte(text[i:], '}'); end >= 0 {
+				out.WriteString(r.photo(text[i+len(PhotoMarker) : i+end]))
+				i += end + 1
+				continue
+			}
+
+		case c == '\\' && i+1 < len(text) && strings.IndexByte(escapableCharacter, text[i+1]) >= 0:
+			out.WriteString(html.EscapeString(text[i+1 : i+2]))
+			i += 2
+			continue
+
+		case c == '`':
+			if end := strings.IndexByte(text[i+1:], '`'); end >= 0 {
+				out.WriteString("<code>" + html.EscapeString(text[i+1:i+1+end]) + "</code>")
+				i += end + 2
+				continue
+			}
+
+		case c == '[':
+			if label, target, n, ok := parseLink(text[i:]); ok {
+				out.WriteString(r.link(label, target))
+				i += n
+				continue
+			}
+
+		case c == '*' || c == '_':
+			if c == '_' && i > 0 && isWordByte(text[i-1]) {
+				break
+			}
+			if rendered, n, ok := r.emphasis(text[i:]); ok {
+				out.WriteString(rendered)
+				i += n
+				continue
+			}
+		}
+
+		out.WriteString(html.EscapeString(text[i : i+1]))
+		i++
+	}
+	return out.String()
+}
+
+// emphasis renders **strong** or *em* text at the start of text,
+// returning the number of bytes consumed.
+func (r *renderer) emphasis(text string) (string, int, bool) {
+	marker := text[:1]
+	for _, delim := range []struct{ marker, tag string }{
+		{marker + marker, "strong"},
+		{marker, "em"},
+	} {
+		if !strings.HasPrefix(text, delim.marker) {
+			continue
+		}
+		start := len(delim.marker)
+		end := strings.Index(text[start:], delim.marker)
+		if end <= 0 || text[start] == ' ' {
+			continue
+		}
+		inner := r.inline(text[start : start+end])
+		return "<" + delim.tag + ">" + inner + "</" + delim.tag + ">", start + end + len(delim.marker), true
+	}
+	return "", 0, false
+}
+
+func (r *renderer) link(label, target string) string {
+	text := r.inline(label)
+	// browsers ignore tabs and newlines in urls, so "java\tscript:" must not slip through
+	target = strings.Map(func(c rune) rune {
+		if c <= ' ' || c == 0x7f {
+			return -1
+		}
+		return c
+	}, target)
+
+	var href string
+	switch {
+	case strings.HasPrefix(target, PlantScheme):
+		if r.opts.PlantLink == nil {
+			return text
+		}
+		link, ok := r.opts.PlantLink(strings.TrimPrefix(target, PlantScheme))
+		if !ok {
+			return text
+		}
+		href = link
+	case safeSchemePattern.MatchString(target):
+		href = target
+	case anySchemePattern.MatchString(target):
+		// javascript:, data: and other schemes are dropped
+		return text
+	default:
+		href = target
+	}
+	return `<a href="` + html.EscapeString(href) + `">` + text + "</a>"
+}
+
+func (r *renderer) photo(place string) string {
+	if r.opts.PhotoURL == nil {
+		return ""
+	}
+	number, err := strconv.Atoi(strings.TrimSpace(place))
+	if err != nil {
+		return ""
+	}
+	url, ok := r.opts.PhotoURL(number)
+	if !ok {
+		return ""
+	}
+	return `<img src="` + html.EscapeString(url) + `" alt="Post photo">`
+}
+
+// parseLink parses [label](target) at the start of text,
+// returning the number of bytes consumed.
+func parseLink(text string) (label, target string, n int, ok bool) {
+	closeLabel := strings.Index(text, "](")
+	if closeLabel < 0 || strings.Contains(text[1:closeLabel], "\n") {
+		return "", "", 0, false
+	}
+	closeTarget := strings.IndexByte(text[closeLabel+2:], ')')
+	if closeTarget < 0 {
+		return "", "", 0, false
+	}
+	closeTarget += closeLabel + 2
+	return text[1:closeLabel], text[closeLabel+2 : closeTarget], closeTarget + 1, true
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80
+}
@@ -0,0 +1,51 @@
+package markdown_test
+
+import (
+	"PlantSite/internal/utils/markdown"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	plantLink := func(ref string) (string, bool) {
+		if ref == "known" {
+			return "/view/plant/known", true
+		}
+		return "", false
+	}
+
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{"paragraphs", "first\nline\n\nsecond", "<p>first\nline</p>\n<p>second</p>\n"},
+		{"headings", "# Title\n### Sub ###", "<h1>Title</h1>\n<h3>Sub</h3>\n"},
+		{"emphasis", "**bold** and *it* and _it_ but snake_case_name", "<p><strong>bold</strong> and <em>it</em> and <em>it</em> but snake_case_name</p>\n"},
+		{"code", "use `<b>` tag\n```\n<script>\n```", "<p>use <code>&lt;b&gt;</code> tag</p>\n<pre><code>&lt;script&gt;</code></pre>\n"},
+		{"lists", "- a\n- b\n1. c", "<ul>\n<li>a</li>\n<li>b</li>\n</ul>\n<ol>\n<li>c</li>\n</ol>\n"},
+		{"quote", "> quoted\n> **text**", "<blockquote>\n<p>quoted\n<strong>text</strong></p>\n</blockquote>\n"},
+		{"rule", "---", "<hr>\n"},
+		{"links", "[site](https://example.com?a=1&b=2) [page](/view/posts)", `<p><a href="https://example.com?a=1&amp;b=2">site</a> <a href="/view/posts">page</a></p>` + "\n"},
+		{"plant links", "[rose](plant:known) [weed](plant:unknown)", `<p><a href="/view/plant/known">rose</a> weed</p>` + "\n"},
+		{"escaped html", "<script>alert('x')</script>", "<p>&lt;script&gt;alert(&#39;x&#39;)&lt;/script&gt;</p>\n"},
+		{"unsafe links", "[a](JavaScript:void) [b](java\tscript:x) [c](data:text/html,x)", "<p>a b c</p>\n"},
+		{"attribute breakout", `[a](/x"onmouseover="alert(1))`, `<p><a href="/x&#34;onmouseover=&#34;alert(1">a</a>)</p>` + "\n"},
+		{"backslash escapes", `\*not em\*`, "<p>*not em*</p>\n"},
+		{"photos", "before \\photo{1} after \\photo{7}", `<p>before <img src="/photo/1.jpg" alt="Post photo"> after </p>` + "\n"},
+	}
+
+	photoURL := func(place int) (string, bool) {
+		if place == 1 {
+			return "/photo/1.jpg", true
+		}
+		return "", false
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, markdown.Render(tt.text, markdown.Options{PlantLink: plantLink, PhotoURL: photoURL}))
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package multihasher
+
+import (
+	authservice "PlantSite/internal/services/auth-service"
+	"errors"
+)
+
+var ErrUnknownHashFormat = errors.New("unknown password hash format")
+
+// FormatHasher is a hasher that recognizes its own hashes.
+type FormatHasher interface {
+	authservice.PasswdHasher
+	Matches(hashedPasswd []byte) bool
+}
+
+// multihasher hashes new passwords with the primary hasher and verifies the hashes of all of them,
+// so that the algorithm can be changed without resetting the passwords.
+type multihasher struct {
+	primary FormatHasher
+	legacy  []FormatHasher
+}
+
+// NewMultiHasher panics if a hasher can't recognize its hashes.
+func NewMultiHasher(primary authservice.PasswdHasher, legacy ...authservice.PasswdHasher) authservice.PasswdHasher {
+	m := &multihasher{
+		primary: formatHasher(primary),
+		legacy:  make([]FormatHasher, 0, len(legacy)),
+	}
+	for _, hasher := range legacy {
+		m.legacy = append(m.legacy, formatHasher(hasher))
+	}
+	return m
+}
+
+func formatHasher(hasher authservice.PasswdHasher) FormatHasher {
+	if hasher == nil {
+		panic("nil hasher")
+	}
+	format, ok := hasher.(FormatHasher)
+	if !ok {
+		panic("hasher doesn't recognize its hashes")
+	}
+	return format
+}
+
+func (m *multihasher) Hash(passwd []byte) ([]byte, error) {
+	return m.primary.Hash(passwd)
+}
+
+func (m *multihasher) Compare(hashedPasswd, plainPasswd []byte) (bool, error) {
+	hasher := m.hasher(hashedPasswd)
+	if hasher == nil {
+		return false, ErrUnknownHashFormat
+	}
+	return hasher.Compare(hashedPasswd, plainPasswd)
+}
+
+// NeedsRehash reports whether the hash was made by a legacy hasher or with outdated parameters.
+func (m *multihasher) NeedsRehash(hashedPasswd []byte) bool {
+	if !m.primary.Matches(hashedPasswd) {
+		return true
+	}
+	rehasher, ok := m.primary.(authservice.PasswdRehasher)
+	return ok && rehasher.NeedsRehash(hashedPasswd)
+}
+
+func (m *multihasher) hasher(hashedPasswd []byte) FormatHasher {
+	if m.primary.Matches(hashedPasswd) {
+		return m.primary
+	}
+	for _, hasher := range m.legacy {
+		if hasher.Matches(hashedPasswd) {
+			return hasher
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package multihasher_test
+
+import (
+	"testing"
+
+	authservice "PlantSite/internal/services/auth-service"
+	"PlantSite/internal/utils/argon2hasher"
+	"PlantSite/internal/utils/bcrypthasher"
+	"PlantSite/internal/utils/multihasher"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestMultiHasher(t *testing.T) {
+	argon := argon2hasher.NewArgon2Hasher(argon2hasher.Params{
+		Memory:      64,
+		Iterations:  1,
+		Parallelism: 1,
+		SaltLength:  16,
+		KeyLength:   32,
+	})
+	bcr := bcrypthasher.NewBcryptHasher(bcrypt.MinCost)
+	hasher := multihasher.NewMultiHasher(argon, bcr)
+	rehasher := hasher.(authservice.PasswdRehasher)
+	password := []byte("test_password")
+
+	t.Run("hashes with primary", func(t *testing.T) {
+		hashed, err := hasher.Hash(password)
+		require.NoError(t, err)
+		assert.Contains(t, string(hashed), "$argon2id$")
+
+		match, err := hasher.Compare(hashed, password)
+		require.NoError(t, err)
+		assert.True(t, match)
+		assert.False(t, rehasher.NeedsRehash(hashed))
+	})
+
+	t.Run("verifies legacy", func(t *testing.T) {
+		hashed, err := bcr.Hash(password)
+		require.NoError(t, err)
+
+		match, err := hasher.Compare(hashed, password)
+		require.NoError(t, err)
+		assert.True(t, match)
+		match, _ = hasher.Compare(hashed, []byte("wrong_password"))
+		assert.False(t, match)
+		assert.True(t, rehasher.NeedsRehash(hashed))
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		match, err := hasher.Compare([]byte("plain"), password)
+		require.ErrorIs(t, err, multihasher.ErrUnknownHashFormat)
+		assert.False(t, match)
+	})
+
+	t.Run("panic when nil", func(t *testing.T) {
+		assert.Panics(t, func() { multihasher.NewMultiHasher(nil) })
+	})
+}
@@ -5,9 +5,11 @@ import (
 	"PlantSite/internal/models/auth"
 	"PlantSite/internal/models/search"
 	albumservice "PlantSite/internal/services/album-service"
+	authservice "PlantSite/internal/services/auth-service"
 	searchservice "PlantSite/internal/services/search-service"
 	"PlantSite/internal/view/components"
 	"PlantSite/internal/view/gintemplrenderer"
+	"context"
 	"errors"
 	"net/http"
 
@@ -19,7 +21,7 @@ func (r *ViewRouter) AlbumsCreateHandler(c *gin.Context) {
 	ctx := c.Request.Context()
 	user := r.auth.UserFromContext(ctx)
 
-	if !user.HasMemberRights() {
+	if !authservice.Policy.Can(user, auth.PermAlbumManage) {
 		c.Redirect(http.StatusFound, "/view/albums")
 		return
 	}
@@ -34,7 +36,7 @@ func (r *ViewRouter) AlbumsHandler(c *gin.Context) {
 
 	var albms []*album.Album
 
-	if !user.HasMemberRights() {
+	if !authservice.Policy.Can(user, auth.PermAlbumRead) {
 		albms = make([]*album.Album, 0)
 	} else {
 		var err error
@@ -74,24 +76,70 @@ func (r *ViewRouter) AlbumViewHandler(c *gin.Context) {
 		return
 	}
 
-	srch := search.NewPlantSearch()
+	plantMap, err := r.albumPlants(ctx, albm, r.albm.Reader(ctx))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	rend := gintemplrenderer.New(c.Request.Context(), http.StatusOK, components.AlbumView(user, albm, plantMap))
+	c.Render(http.StatusOK, rend)
+}
 
-	albumFilter := search.NewPlantAlbumFilter(albm.ID(), nil)
-	srch.AddFilter(albumFilter)
+func (r *ViewRouter) AlbumSharedHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	user := r.auth.UserFromContext(ctx)
 
-	plants, err := r.srch.SearchPlants(ctx, srch)
+	token := c.Param("token")
+	albm, err := r.albm.GetSharedAlbum(ctx, token)
+	if errors.Is(err, album.ErrAlbumNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "album is not shared"})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	reader := r.albm.Reader(ctx)
+	reader.ShareToken = token
+	plantMap, err := r.albumPlants(ctx, albm, reader)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
+	rend := gintemplrenderer.New(c.Request.Context(), http.StatusOK, components.AlbumShared(user, albm, plantMap))
+	c.Render(http.StatusOK, rend)
+}
+
+func (r *ViewRouter) PublicAlbumsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	user := r.auth.UserFromContext(ctx)
+
+	albms, err := r.albm.ListPublicAlbums(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	rend := gintemplrenderer.New(c.Request.Context(), http.StatusOK, components.PublicAlbums(user, albms))
+	c.Render(http.StatusOK, rend)
+}
+
+// albumPlants searches the plants of the album as the reader, so that the search checks the access too
+func (r *ViewRouter) albumPlants(ctx context.Context, albm *album.Album, reader album.Reader) (map[uuid.UUID]*searchservice.SearchPlant, error) {
+	srch := search.NewPlantSearch()
+	srch.AddFilter(search.NewPlantAlbumFilter(albm.ID(), reader, nil))
+
+	plants, err := r.srch.SearchPlants(ctx, srch)
+	if err != nil {
+		return nil, err
+	}
 
 	plantMap := make(map[uuid.UUID]*searchservice.SearchPlant)
 	for _, plnt := range plants {
 		plantMap[plnt.ID] = plnt
 		plnt.MainPhoto.URL = r.plantMedia.GetUrl(plnt.MainPhoto.URL)
 	}
-	rend := gintemplrenderer.New(c.Request.Context(), http.StatusOK, components.AlbumView(user, albm, plantMap))
-	c.Render(http.StatusOK, rend)
+	return plantMap, nil
 }
 
 func (r *ViewRouter) AlbumUpdateHandler(c *gin.Context) {
@@ -119,6 +167,12 @@ func (r *ViewRouter) AlbumUpdateHandler(c *gin.Context) {
 		return
 	}
 
+	// Public albums are read by anyone but updated only by the owner
+	if albm.GetOwnerID() != user.ID() {
+		c.Redirect(http.StatusFound, "/view/album/"+albm.ID().String())
+		return
+	}
+
 	rend := gintemplrenderer.New(c.Request.Context(), http.StatusOK, components.AlbumUpdate(user, albm))
 	c.Render(http.StatusOK, rend)
 }
@@ -0,0 +1,16 @@
+package view
+
+import (
+	"PlantSite/internal/view/components"
+	"PlantSite/internal/view/gintemplrenderer"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (r *ViewRouter) VerifyEmailHandler(c *gin.Context) {
+	token := c.Query("token")
+
+	rend := gintemplrenderer.New(c.Request.Context(), http.StatusOK, components.VerifyEmail(token))
+	c.Render(http.StatusOK, rend)
+}
@@ -8,6 +8,16 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// OIDCLoginURL starts the login with the identity provider, the login page offers it when set.
+var OIDCLoginURL = ""
+
+func UpdateOIDCLoginURL(url string) {
+	if url == "" {
+		panic("empty oidc login url")
+	}
+	OIDCLoginURL = url
+}
+
 func (r *ViewRouter) LoginHandler(c *gin.Context) {
 	ctx := c.Request.Context()
 	user := r.auth.UserFromContext(ctx)
@@ -16,7 +26,7 @@ func (r *ViewRouter) LoginHandler(c *gin.Context) {
 		return
 	}
 
-	rend := gintemplrenderer.New(c.Request.Context(), http.StatusOK, components.Login())
+	rend := gintemplrenderer.New(c.Request.Context(), http.StatusOK, components.Login(OIDCLoginURL))
 	c.Render(http.StatusOK, rend)
 }
 
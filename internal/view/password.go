@@ -0,0 +1,32 @@
+package view
+
+import (
+	"PlantSite/internal/view/components"
+	"PlantSite/internal/view/gintemplrenderer"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (r *ViewRouter) ForgotPasswordHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	user := r.auth.UserFromContext(ctx)
+	if user.IsAuthenticated() {
+		c.Redirect(http.StatusFound, "/view")
+		return
+	}
+
+	rend := gintemplrenderer.New(c.Request.Context(), http.StatusOK, components.ForgotPassword())
+	c.Render(http.StatusOK, rend)
+}
+
+func (r *ViewRouter) ResetPasswordHandler(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		c.Redirect(http.StatusFound, "/view/password/forgot")
+		return
+	}
+
+	rend := gintemplrenderer.New(c.Request.Context(), http.StatusOK, components.ResetPassword(token))
+	c.Render(http.StatusOK, rend)
+}
@@ -1,7 +1,10 @@
 package view
 
 import (
+	pagequery "PlantSite/internal/api-utils/query-filters/page-query"
 	plantsquery "PlantSite/internal/api-utils/query-filters/plants-query"
+	"PlantSite/internal/models/auth"
+	authservice "PlantSite/internal/services/auth-service"
 	"PlantSite/internal/view/components"
 	"PlantSite/internal/view/gintemplrenderer"
 	"net/http"
@@ -21,6 +24,16 @@ func (r *ViewRouter) PlantsHandler(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
+	total, err := r.srch.CountPlants(c.Request.Context(), srch)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	nav := components.PageNav{
+		Path:      "/view/plants",
+		Total:     total,
+		NextQuery: pagequery.NextPageQuery(c.Request.URL.Query(), srch.Page(), len(plnts), total),
+	}
 
 	for _, plnt := range plnts {
 		plnt.MainPhoto.URL = r.plantMedia.GetUrl(plnt.MainPhoto.URL)
@@ -29,14 +42,14 @@ func (r *ViewRouter) PlantsHandler(c *gin.Context) {
 	ctx := c.Request.Context()
 	user := r.auth.UserFromContext(ctx)
 
-	rend := gintemplrenderer.New(c.Request.Context(), http.StatusOK, components.Plants(user, plnts))
+	rend := gintemplrenderer.New(c.Request.Context(), http.StatusOK, components.Plants(user, plnts, nav))
 	c.Render(http.StatusOK, rend)
 }
 
 func (r *ViewRouter) CreatePlantHandler(c *gin.Context) {
 	ctx := c.Request.Context()
 	user := r.auth.UserFromContext(ctx)
-	if !user.HasAuthorRights() {
+	if !authservice.Policy.Can(user, auth.PermPlantEdit) {
 		c.Redirect(http.StatusFound, "/view/plants")
 		return
 	}
@@ -84,7 +97,7 @@ func (r *ViewRouter) PlantViewHandler(c *gin.Context) {
 func (r *ViewRouter) UpdatePlantHandler(c *gin.Context) {
 	ctx := c.Request.Context()
 	user := r.auth.UserFromContext(ctx)
-	if !user.HasAuthorRights() {
+	if !authservice.Policy.Can(user, auth.PermPlantEdit) {
 		c.Redirect(http.StatusFound, "/view/plants")
 		return
 	}
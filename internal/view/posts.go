@@ -1,10 +1,13 @@
 package view
 
 import (
+	pagequery "PlantSite/internal/api-utils/query-filters/page-query"
 	postsquery "PlantSite/internal/api-utils/query-filters/posts-query"
+	"PlantSite/internal/models/auth"
 	"PlantSite/internal/models/post"
 	"PlantSite/internal/models/post/parser"
 	"PlantSite/internal/models/search"
+	authservice "PlantSite/internal/services/auth-service"
 	searchservice "PlantSite/internal/services/search-service"
 	"PlantSite/internal/view/components"
 	"PlantSite/internal/view/gintemplrenderer"
@@ -71,6 +74,16 @@ func (r *ViewRouter) PostsHandler(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
+	total, err := r.srch.CountPosts(ctx, postFilters)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	nav := components.PageNav{
+		Path:      "/view/posts",
+		Total:     total,
+		NextQuery: pagequery.NextPageQuery(c.Request.URL.Query(), postFilters.Page(), len(posts), total),
+	}
 
 	for _, post := range posts {
 		for i, _ := range post.Photos {
@@ -100,7 +113,7 @@ func (r *ViewRouter) PostsHandler(c *gin.Context) {
 		plnt.MainPhoto.URL = r.plantMedia.GetUrl(plnt.MainPhoto.URL)
 	}
 
-	rend := gintemplrenderer.New(c.Request.Context(), http.StatusOK, components.Posts(user, posts, tags, authors, plantMap))
+	rend := gintemplrenderer.New(c.Request.Context(), http.StatusOK, components.Posts(user, posts, tags, authors, plantMap, nav))
 	c.Render(http.StatusOK, rend)
 }
 
@@ -183,7 +196,7 @@ func (r *ViewRouter) PostViewHandler(c *gin.Context) {
 func (r *ViewRouter) CreatePostHandler(c *gin.Context) {
 	ctx := c.Request.Context()
 	user := r.auth.UserFromContext(ctx)
-	if !user.HasAuthorRights() {
+	if !authservice.Policy.Can(user, auth.PermPostEdit) {
 		c.Redirect(http.StatusFound, "/view/plants")
 		return
 	}
@@ -195,7 +208,7 @@ func (r *ViewRouter) CreatePostHandler(c *gin.Context) {
 func (r *ViewRouter) UpdatePostHandler(c *gin.Context) {
 	ctx := c.Request.Context()
 	user := r.auth.UserFromContext(ctx)
-	if !user.HasAuthorRights() {
+	if !authservice.Policy.Can(user, auth.PermPostEdit) && !authservice.Policy.Can(user, auth.PermPostModerate) {
 		c.Redirect(http.StatusFound, "/view/plants")
 		return
 	}
@@ -0,0 +1,34 @@
+package view
+
+import (
+	"PlantSite/internal/models/auth"
+	"PlantSite/internal/view/components"
+	"PlantSite/internal/view/gintemplrenderer"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (r *ViewRouter) ProfileHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	user := r.auth.UserFromContext(ctx)
+	if !user.IsAuthenticated() {
+		c.Redirect(http.StatusFound, "/view/login")
+		return
+	}
+
+	email := ""
+	if withEmail, ok := user.(interface{ Email() string }); ok {
+		email = withEmail.Email()
+	}
+
+	// The page is still useful without the list, so it is rendered anyway
+	sessions, err := r.auth.ListSessions(ctx)
+	if err != nil {
+		c.Error(err)
+	}
+
+	rend := gintemplrenderer.New(c.Request.Context(), http.StatusOK,
+		components.Profile(user, email, auth.IsEmailVerified(user), sessions, r.auth.CurrentSessionID(ctx)))
+	c.Render(http.StatusOK, rend)
+}
@@ -50,6 +50,10 @@ func (r *ViewRouter) Init(
 	gr.GET("/login", r.LoginHandler)
 	gr.GET("/register", r.RegisterHandler)
 	gr.GET("/logout", r.LogoutHandler)
+	gr.GET("/password/forgot", r.ForgotPasswordHandler)
+	gr.GET("/password/reset", r.ResetPasswordHandler)
+	gr.GET("/email/verify", r.VerifyEmailHandler)
+	gr.GET("/profile", r.ProfileHandler)
 
 	gr.GET("/plants", r.PlantsHandler)
 	gr.GET("/plant/create", r.CreatePlantHandler)
@@ -62,6 +66,8 @@ func (r *ViewRouter) Init(
 	gr.GET("/post/:id/update", r.UpdatePostHandler)
 
 	gr.GET("/albums", r.AlbumsHandler)
+	gr.GET("/albums/public", r.PublicAlbumsHandler)
+	gr.GET("/album/shared/:token", r.AlbumSharedHandler)
 	gr.GET("/album/:id", r.AlbumViewHandler)
 	gr.GET("/album/create", r.AlbumsCreateHandler)
 	gr.GET("/album/:id/update", r.AlbumUpdateHandler)
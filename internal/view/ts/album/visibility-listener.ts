@@ -0,0 +1,44 @@
+document.addEventListener('DOMContentLoaded', () => {
+    const visibilitySelect = document.getElementById('album-visibility') as HTMLSelectElement;
+    const shareLink = document.getElementById('album-share-link') as HTMLInputElement;
+    if (!visibilitySelect || !shareLink) return;
+
+    const albumId = visibilitySelect.dataset.albumId;
+    let current = visibilitySelect.value;
+
+    const showShareLink = (path: string) => {
+        if (path) {
+            shareLink.value = window.location.origin + path;
+            shareLink.classList.remove('hidden');
+        } else {
+            shareLink.value = '';
+            shareLink.classList.add('hidden');
+        }
+    };
+    showShareLink(shareLink.value);
+
+    shareLink.addEventListener('focus', () => shareLink.select());
+
+    visibilitySelect.addEventListener('change', () => {
+        const visibility = visibilitySelect.value;
+        fetch(`/api/album/visibility/${albumId}`, {
+            method: 'PUT',
+            body: JSON.stringify({visibility: visibility}),
+            headers: {
+                'Content-Type': 'application/json'
+            }
+        }).then(response => {
+            if (!response.ok) {
+                throw new Error('Failed to update album visibility');
+            }
+            return response.json();
+        }).then(data => {
+            current = visibility;
+            const token = data.album.share_token;
+            showShareLink(token ? `/view/album/shared/${token}` : '');
+        }).catch(error => {
+            console.error(error);
+            visibilitySelect.value = current;
+        });
+    });
+});
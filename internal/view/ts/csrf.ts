@@ -0,0 +1,23 @@
+// Adds the session CSRF token from the layout to mutating requests of the site API.
+(() => {
+    const meta = document.querySelector('meta[name="csrf-token"]') as HTMLMetaElement | null;
+    const token = meta ? meta.content : '';
+    if (!token) {
+        return;
+    }
+
+    const safeMethods = ['GET', 'HEAD', 'OPTIONS'];
+    const originalFetch = window.fetch.bind(window);
+
+    window.fetch = (input: RequestInfo | URL, init?: RequestInit): Promise<Response> => {
+        const request = new Request(input, init);
+        const sameOrigin = new URL(request.url, window.location.href).origin === window.location.origin;
+        if (!sameOrigin || safeMethods.includes(request.method.toUpperCase())) {
+            return originalFetch(input, init);
+        }
+
+        const headers = new Headers(init?.headers ?? (input instanceof Request ? input.headers : undefined));
+        headers.set('X-CSRF-Token', token);
+        return originalFetch(input, { ...init, headers: headers });
+    };
+})();
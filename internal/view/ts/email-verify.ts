@@ -0,0 +1,69 @@
+function handleVerifyEmail(event: Event): void {
+    event.preventDefault();
+
+    const requestData = {
+        token: (document.getElementById('token') as HTMLInputElement).value
+    };
+
+    fetch('/api/auth/email/verify', {
+        method: 'POST',
+        headers: {
+            'Content-Type': 'application/json',
+        },
+        body: JSON.stringify(requestData),
+    })
+    .then(response => {
+        if (response.ok) {
+            alert('Email confirmed');
+            window.location.href = '/view'; // Redirect on success
+        } else {
+            return response.json().then(errorData => {
+                throw new Error(errorData.error || 'Email verification failed');
+            });
+        }
+    })
+    .catch(error => {
+        console.error('Error:', error);
+        alert(error.message || 'An error occurred during email verification');
+    });
+}
+
+function handleResendVerification(event: Event): void {
+    event.preventDefault();
+
+    const requestData = {
+        email: (document.getElementById('email') as HTMLInputElement).value
+    };
+
+    fetch('/api/auth/email/resend', {
+        method: 'POST',
+        headers: {
+            'Content-Type': 'application/json',
+        },
+        body: JSON.stringify(requestData),
+    })
+    .then(response => {
+        if (response.ok) {
+            alert('If the email awaits confirmation, a new link has been sent');
+        } else {
+            return response.json().then(errorData => {
+                throw new Error(errorData.error || 'Failed to send link');
+            });
+        }
+    })
+    .catch(error => {
+        console.error('Error:', error);
+        alert(error.message || 'An error occurred while sending the link');
+    });
+}
+
+document.addEventListener('DOMContentLoaded', () => {
+    const verifyForm = document.getElementById('verifyEmailForm');
+    if (verifyForm) {
+        verifyForm.addEventListener('submit', handleVerifyEmail);
+    }
+    const resendForm = document.getElementById('resendVerificationForm');
+    if (resendForm) {
+        resendForm.addEventListener('submit', handleResendVerification);
+    }
+});
@@ -3,7 +3,8 @@ function handleLogin(event: Event): void {
     
     const formData = {
         username: (document.getElementById('username') as HTMLInputElement).value,
-        password: (document.getElementById('password') as HTMLInputElement).value
+        password: (document.getElementById('password') as HTMLInputElement).value,
+        remember: (document.getElementById('remember') as HTMLInputElement).checked
     };
 
     fetch('/api/auth/login', {
@@ -19,7 +20,7 @@ function handleLogin(event: Event): void {
             window.location.href = '/view'; // Redirect on success
         } else {
             return response.json().then(errorData => {
-                throw new Error(errorData.message || 'Login failed');
+                throw new Error(errorData.error || 'Login failed');
             });
         }
     })
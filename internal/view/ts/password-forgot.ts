@@ -0,0 +1,36 @@
+function handleForgotPassword(event: Event): void {
+    event.preventDefault();
+
+    const formData = {
+        email: (document.getElementById('email') as HTMLInputElement).value
+    };
+
+    fetch('/api/auth/password/forgot', {
+        method: 'POST',
+        headers: {
+            'Content-Type': 'application/json',
+        },
+        body: JSON.stringify(formData),
+    })
+    .then(response => {
+        if (response.ok) {
+            alert('If the email is registered, a reset link has been sent to it');
+            window.location.href = '/view/login'; // Redirect on success
+        } else {
+            return response.json().then(errorData => {
+                throw new Error(errorData.error || 'Password reset request failed');
+            });
+        }
+    })
+    .catch(error => {
+        console.error('Error:', error);
+        alert(error.message || 'An error occurred during password reset request');
+    });
+}
+
+document.addEventListener('DOMContentLoaded', () => {
+    const forgotForm = document.getElementById('forgotPasswordForm');
+    if (forgotForm) {
+        forgotForm.addEventListener('submit', handleForgotPassword);
+    }
+});
@@ -0,0 +1,47 @@
+function handleResetPassword(event: Event): void {
+    event.preventDefault();
+
+    const formData = {
+        token: (document.getElementById('token') as HTMLInputElement).value,
+        password: (document.getElementById('password') as HTMLInputElement).value,
+        passwordConfirm: (document.getElementById('password-confirm') as HTMLInputElement).value
+    };
+
+    if (formData.password !== formData.passwordConfirm) {
+        alert('Passwords do not match');
+        return;
+    }
+
+    const requestData = {
+        token: formData.token,
+        password: formData.password
+    };
+
+    fetch('/api/auth/password/reset', {
+        method: 'POST',
+        headers: {
+            'Content-Type': 'application/json',
+        },
+        body: JSON.stringify(requestData),
+    })
+    .then(response => {
+        if (response.ok) {
+            window.location.href = '/view/login'; // Redirect on success
+        } else {
+            return response.json().then(errorData => {
+                throw new Error(errorData.error || 'Password reset failed');
+            });
+        }
+    })
+    .catch(error => {
+        console.error('Error:', error);
+        alert(error.message || 'An error occurred during password reset');
+    });
+}
+
+document.addEventListener('DOMContentLoaded', () => {
+    const resetForm = document.getElementById('resetPasswordForm');
+    if (resetForm) {
+        resetForm.addEventListener('submit', handleResetPassword);
+    }
+});
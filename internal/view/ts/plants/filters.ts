@@ -58,6 +58,11 @@ export class PlantNameFilter extends StringFilter {
     name = 'name';
 }
 
+export class PlantTextFilter extends StringFilter {
+    type: string = 'q';
+    name = 'q';
+}
+
 export class PlantLatinNameFilter extends StringFilter {
     type: string = 'latin_name'; // var for query string
     name = 'latin-name'; // var for form data
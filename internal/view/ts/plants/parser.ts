@@ -1,6 +1,7 @@
 import { PlantFilter, PlantFilterJSON } from './types.js';
 import {
     PlantNameFilter,
+    PlantTextFilter,
     PlantLatinNameFilter,
     PlantHeightFilter,
     PlantLightRelationFilter,
@@ -15,6 +16,7 @@ import {
 
 export class PlantFilterParser {
     private static filterMap: Record<string, new () => PlantFilter> = {
+        'q': PlantTextFilter,
         'name': PlantNameFilter,
         'latin_name': PlantLatinNameFilter,
         'height': PlantHeightFilter,
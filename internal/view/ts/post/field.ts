@@ -12,6 +12,12 @@ export class PostContentField extends PostField {
     valueType: string = StringType;
 }
 
+export class PostFormatField extends PostField {
+    id: string = 'format';
+    name: string = 'format';
+    valueType: string = StringType;
+}
+
 export class PostTagsField extends PostField {
     id: string = 'tags[]';
     name: string = 'tags';
@@ -2,6 +2,7 @@ import { PostField } from './types.js';
 import {
     PostTitleField,
     PostContentField,
+    PostFormatField,
     PostTagsField,
     PostPhotosField
 } from './field.js';
@@ -10,6 +11,7 @@ export class PostFieldParser {
     private static fieldMap: Record<string, new () => PostField> = {
         'title': PostTitleField,
         'content': PostContentField,
+        'format': PostFormatField,
         'tags': PostTagsField,
         'photos': PostPhotosField,
     };
@@ -0,0 +1,120 @@
+interface PlantSuggestion {
+    id: string;
+    name: string;
+    latin_name: string;
+}
+
+// Plant reference syntaxes: latex-like \plant{name} and markdown [label](plant:name)
+const plantReferences = [
+    { start: '\\plant{', end: '}' },
+    { start: '](plant:', end: ')' },
+];
+
+// PlantAutocomplete suggests plant names while the author types a plant reference in the post content.
+export class PlantAutocomplete {
+    private textarea: HTMLTextAreaElement;
+    private resultsContainer: HTMLDivElement;
+    private debounceTimeout: number | null = null;
+
+    constructor(textarea: HTMLTextAreaElement) {
+        this.textarea = textarea;
+        this.resultsContainer = document.createElement('div');
+        this.resultsContainer.className = 'hidden mt-1 w-full rounded-md border border-gray-200 bg-white shadow-lg';
+        this.textarea.insertAdjacentElement('afterend', this.resultsContainer);
+
+        this.textarea.addEventListener('input', () => this.handleInput());
+        document.addEventListener('click', (e) => {
+            if (!this.resultsContainer.contains(e.target as Node)) {
+                this.hide();
+            }
+        });
+    }
+
+    // currentQuery returns the unfinished plant name right before the cursor, if any.
+    private currentQuery(): { start: number, end: string, query: string } | null {
+        const beforeCursor = this.textarea.value.slice(0, this.textarea.selectionStart);
+        let current: { start: number, end: string, query: string } | null = null;
+        for (const ref of plantReferences) {
+            const start = beforeCursor.lastIndexOf(ref.start);
+            if (start === -1 || (current && current.start > start + ref.start.length)) continue;
+            const query = beforeCursor.slice(start + ref.start.length);
+            if (query.includes(ref.end) || query.includes('\n')) continue;
+            current = { start: start + ref.start.length, end: ref.end, query: query };
+        }
+        return current;
+    }
+
+    private handleInput(): void {
+        if (this.debounceTimeout) {
+            clearTimeout(this.debounceTimeout);
+        }
+        this.debounceTimeout = window.setTimeout(async () => {
+            const current = this.currentQuery();
+            if (!current || current.query.trim().length < 2) {
+                this.hide();
+                return;
+            }
+            try {
+                const suggestions = await PlantAutocomplete.suggest(current.query.trim());
+                this.display(current.start, current.end, suggestions);
+            } catch (error) {
+                console.error('Plant suggestion failed:', error);
+                this.hide();
+            }
+        }, 300);
+    }
+
+    static async suggest(name: string): Promise<PlantSuggestion[]> {
+        const response = await fetch(`/api/search/plants/suggest?name=${encodeURIComponent(name)}`);
+        if (!response.ok) {
+            throw new Error('Failed to fetch plant suggestions');
+        }
+        return await response.json();
+    }
+
+    private display(start: number, end: string, suggestions: PlantSuggestion[]): void {
+        this.resultsContainer.innerHTML = '';
+        if (suggestions.length === 0) {
+            this.hide();
+            return;
+        }
+        suggestions.forEach(suggestion => {
+            const item = document.createElement('div');
+            item.className = 'px-4 py-2 cursor-pointer hover:bg-gray-50';
+
+            const title = document.createElement('div');
+            title.className = 'text-sm font-medium text-gray-900 truncate';
+            title.textContent = suggestion.name;
+
+            const latinName = document.createElement('div');
+            latinName.className = 'text-xs text-gray-500 truncate';
+            latinName.textContent = suggestion.latin_name;
+
+            item.appendChild(title);
+            item.appendChild(latinName);
+            item.addEventListener('click', () => this.select(start, end, suggestion));
+            this.resultsContainer.appendChild(item);
+        });
+        this.resultsContainer.classList.remove('hidden');
+    }
+
+    private select(start: number, end: string, suggestion: PlantSuggestion): void {
+        const value = this.textarea.value;
+        const cursor = this.textarea.selectionStart;
+        const inserted = suggestion.name + end;
+        this.textarea.value = value.slice(0, start) + inserted + value.slice(cursor);
+        this.textarea.selectionStart = this.textarea.selectionEnd = start + inserted.length;
+        this.textarea.focus();
+        this.hide();
+    }
+
+    private hide(): void {
+        this.resultsContainer.classList.add('hidden');
+    }
+}
+
+document.addEventListener('DOMContentLoaded', () => {
+    const content = document.getElementById('content') as HTMLTextAreaElement;
+    if (!content) return;
+    new PlantAutocomplete(content);
+});
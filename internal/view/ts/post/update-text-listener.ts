@@ -24,6 +24,12 @@ document.addEventListener('DOMContentLoaded', () => {
                 window.location.href = '/view/posts';
             } else {
                 console.error(response);
+                // Unknown plant names come back with similar names to pick from
+                response.json().then(data => {
+                    if (data['suggestions'] && data['suggestions'].length > 0) {
+                        alert(data['error']);
+                    }
+                });
                 throw new Error('Failed to update post');
             }
         });
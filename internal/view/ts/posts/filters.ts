@@ -40,6 +40,11 @@ export class PostTitleFilter extends StringFilter {
     name = 'title';
 }
 
+export class PostTextFilter extends StringFilter {
+    type: string = 'q';
+    name = 'q';
+}
+
 export class PostTagsFilter extends OptionArrayFilter {
     type: string = 'tags';
     name = 'tags';
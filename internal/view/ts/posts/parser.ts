@@ -1,12 +1,14 @@
 import { PostFilter } from './types.js';
 import {
     PostTitleFilter,
+    PostTextFilter,
     PostTagsFilter,
     PostAuthorFilter
 } from './filters.js';
 
 export class PostFilterParser {
     private static filterMap: Record<string, new () => PostFilter> = {
+        'q': PostTextFilter,
         'title': PostTitleFilter,
         'tags': PostTagsFilter,
         'author': PostAuthorFilter
@@ -0,0 +1,115 @@
+function putProfile(path: string, requestData: object, successMessage: string): void {
+    fetch('/api/auth/profile/' + path, {
+        method: 'PUT',
+        headers: {
+            'Content-Type': 'application/json',
+        },
+        body: JSON.stringify(requestData),
+    })
+    .then(response => {
+        if (response.ok) {
+            alert(successMessage);
+            window.location.reload();
+        } else {
+            return response.json().then(errorData => {
+                throw new Error(errorData.error || 'Profile update failed');
+            });
+        }
+    })
+    .catch(error => {
+        console.error('Error:', error);
+        alert(error.message || 'An error occurred during profile update');
+    });
+}
+
+function handleChangeName(event: Event): void {
+    event.preventDefault();
+
+    putProfile('name', {
+        name: (document.getElementById('name') as HTMLInputElement).value
+    }, 'Name changed');
+}
+
+function handleChangeEmail(event: Event): void {
+    event.preventDefault();
+
+    const email = (document.getElementById('email') as HTMLInputElement).value;
+    if (!email.includes('@')) {
+        alert('Invalid email');
+        return;
+    }
+
+    putProfile('email', {
+        current_password: (document.getElementById('email-password') as HTMLInputElement).value,
+        email: email
+    }, 'Email changed, check it to confirm the address');
+}
+
+function handleChangePassword(event: Event): void {
+    event.preventDefault();
+
+    const formData = {
+        currentPassword: (document.getElementById('current-password') as HTMLInputElement).value,
+        newPassword: (document.getElementById('new-password') as HTMLInputElement).value,
+        newPasswordConfirm: (document.getElementById('new-password-confirm') as HTMLInputElement).value
+    };
+
+    if (formData.newPassword !== formData.newPasswordConfirm) {
+        alert('Passwords do not match');
+        return;
+    }
+
+    putProfile('password', {
+        current_password: formData.currentPassword,
+        new_password: formData.newPassword
+    }, 'Password changed');
+}
+
+function revokeSessions(path: string, confirmMessage: string): void {
+    if (!confirm(confirmMessage)) {
+        return;
+    }
+
+    fetch('/api/auth/sessions' + path, {
+        method: 'DELETE',
+    })
+    .then(response => {
+        if (response.ok) {
+            window.location.reload();
+        } else {
+            return response.json().then(errorData => {
+                throw new Error(errorData.error || 'Sign out failed');
+            });
+        }
+    })
+    .catch(error => {
+        console.error('Error:', error);
+        alert(error.message || 'An error occurred during sign out');
+    });
+}
+
+document.addEventListener('DOMContentLoaded', () => {
+    const nameForm = document.getElementById('nameForm');
+    if (nameForm) {
+        nameForm.addEventListener('submit', handleChangeName);
+    }
+    const emailForm = document.getElementById('emailForm');
+    if (emailForm) {
+        emailForm.addEventListener('submit', handleChangeEmail);
+    }
+    const passwordForm = document.getElementById('passwordForm');
+    if (passwordForm) {
+        passwordForm.addEventListener('submit', handleChangePassword);
+    }
+    document.querySelectorAll<HTMLButtonElement>('.revoke-session').forEach(button => {
+        button.addEventListener('click', () => {
+            revokeSessions('/' + button.dataset.sessionId, 'Sign out this device?');
+        });
+    });
+    const revokeOthers = document.getElementById('revokeOtherSessions');
+    if (revokeOthers) {
+        revokeOthers.addEventListener('click', () => {
+            revokeSessions('', 'Sign out on all other devices?');
+        });
+    }
+});
@@ -33,6 +33,7 @@ function handleRegister(event: Event): void {
     })
     .then(response => {
         if (response.ok) {
+            alert('Check your email to confirm the address');
             window.location.href = '/view/login'; // Redirect on success
         } else {
             return response.json().then(errorData => {
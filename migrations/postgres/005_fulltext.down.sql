@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS post_search_vector_idx;
+ALTER TABLE post DROP COLUMN search_vector;
+
+DROP INDEX IF EXISTS plant_search_vector_idx;
+ALTER TABLE plant DROP COLUMN search_vector;
\ No newline at end of file
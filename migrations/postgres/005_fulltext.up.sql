@@ -0,0 +1,17 @@
+ALTER TABLE plant ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian', coalesce(name, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(latin_name, '')), 'A') ||
+    setweight(to_tsvector('russian', coalesce(description, '')), 'B') ||
+    setweight(to_tsvector('english', coalesce(description, '')), 'B')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS plant_search_vector_idx ON plant USING GIN (search_vector);
+
+ALTER TABLE post ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('russian', coalesce(body, '')), 'B') ||
+    setweight(to_tsvector('english', coalesce(body, '')), 'B')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS post_search_vector_idx ON post USING GIN (search_vector);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS plant_latin_name_trgm_idx;
+DROP INDEX IF EXISTS plant_name_trgm_idx;
@@ -0,0 +1,4 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS plant_name_trgm_idx ON plant USING GIN (lower(name) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS plant_latin_name_trgm_idx ON plant USING GIN (lower(latin_name) gin_trgm_ops);
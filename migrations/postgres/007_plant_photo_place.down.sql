@@ -0,0 +1 @@
+ALTER TABLE plant_photo DROP COLUMN place_number;
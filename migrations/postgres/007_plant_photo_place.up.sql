@@ -0,0 +1,12 @@
+ALTER TABLE plant_photo ADD COLUMN place_number int;
+
+UPDATE plant_photo SET place_number = numbered.place_number
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY plant_id ORDER BY id) AS place_number
+    FROM plant_photo
+) AS numbered
+WHERE plant_photo.id = numbered.id;
+
+ALTER TABLE plant_photo ALTER COLUMN place_number SET NOT NULL;
+ALTER TABLE plant_photo ADD CONSTRAINT plant_photo_place_number_positive CHECK (place_number > 0);
+ALTER TABLE plant_photo ADD CONSTRAINT plant_photo_place_number_unique UNIQUE (plant_id, place_number);
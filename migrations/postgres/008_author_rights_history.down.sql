@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS author_rights_history_record ON author;
+DROP FUNCTION IF EXISTS record_author_rights_change();
+DROP TABLE IF EXISTS author_rights_history;
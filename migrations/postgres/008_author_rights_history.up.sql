@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS author_rights_history (
+    id BIGSERIAL PRIMARY KEY,
+    author_id UUID NOT NULL,
+    has_rights BOOLEAN NOT NULL,
+    changed_at TIMESTAMPTZ NOT NULL,
+    FOREIGN KEY (author_id) REFERENCES author(id)
+);
+
+CREATE INDEX IF NOT EXISTS author_rights_history_author_idx ON author_rights_history (author_id, changed_at);
+
+INSERT INTO author_rights_history (author_id, has_rights, changed_at)
+SELECT id, has_rights, CASE WHEN has_rights THEN grant_at ELSE revoke_at END
+FROM author;
+
+-- record every grant and revoke of author rights
+CREATE OR REPLACE FUNCTION record_author_rights_change()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT'
+        OR OLD.has_rights IS DISTINCT FROM NEW.has_rights
+        OR OLD.grant_at IS DISTINCT FROM NEW.grant_at
+        OR OLD.revoke_at IS DISTINCT FROM NEW.revoke_at THEN
+        INSERT INTO author_rights_history (author_id, has_rights, changed_at)
+        VALUES (NEW.id, NEW.has_rights, CASE WHEN NEW.has_rights THEN NEW.grant_at ELSE NEW.revoke_at END);
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER author_rights_history_record
+AFTER INSERT OR UPDATE ON author
+FOR EACH ROW EXECUTE FUNCTION record_author_rights_change();
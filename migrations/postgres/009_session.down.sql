@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS session_expires_at_idx;
+DROP TABLE IF EXISTS "session";
@@ -0,0 +1,8 @@
+-- member_id has no foreign key: admins from the config aren't stored in app_user
+CREATE TABLE IF NOT EXISTS "session" (
+    id UUID PRIMARY KEY,
+    member_id UUID NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS session_expires_at_idx ON "session" (expires_at);
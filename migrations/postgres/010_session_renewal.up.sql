@@ -0,0 +1,2 @@
+ALTER TABLE "session" ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+ALTER TABLE "session" ADD COLUMN IF NOT EXISTS remember BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS password_reset_token_expires_at_idx;
+DROP TABLE IF EXISTS password_reset_token;
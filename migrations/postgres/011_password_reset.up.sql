@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS password_reset_token (
+    token_hash BYTEA PRIMARY KEY,
+    member_id UUID NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    FOREIGN KEY (member_id) REFERENCES app_user(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS password_reset_token_expires_at_idx ON password_reset_token (expires_at);
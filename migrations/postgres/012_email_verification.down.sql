@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS email_verification_token_expires_at_idx;
+DROP TABLE IF EXISTS email_verification_token;
+ALTER TABLE app_user DROP COLUMN IF EXISTS email_verified;
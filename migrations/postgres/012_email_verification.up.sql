@@ -0,0 +1,12 @@
+-- Accounts created before verification was introduced are trusted
+ALTER TABLE app_user ADD COLUMN IF NOT EXISTS email_verified BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE app_user ALTER COLUMN email_verified SET DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS email_verification_token (
+    token_hash BYTEA PRIMARY KEY,
+    member_id UUID NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    FOREIGN KEY (member_id) REFERENCES app_user(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS email_verification_token_expires_at_idx ON email_verification_token (expires_at);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS login_attempt_expires_at_idx;
+DROP TABLE IF EXISTS login_attempt;
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS login_attempt (
+    attempt_key TEXT PRIMARY KEY,
+    failures INTEGER NOT NULL,
+    last_failure_at TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS login_attempt_expires_at_idx ON login_attempt (expires_at);
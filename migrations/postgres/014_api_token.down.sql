@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS api_token_member_id_idx;
+DROP TABLE IF EXISTS api_token;
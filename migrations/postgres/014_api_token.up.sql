@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS api_token (
+    id UUID PRIMARY KEY,
+    member_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    scope TEXT NOT NULL CHECK (scope IN ('read-only', 'author', 'admin')),
+    token_hash BYTEA NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL,
+    FOREIGN KEY (member_id) REFERENCES app_user(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS api_token_member_id_idx ON api_token (member_id);
@@ -0,0 +1,2 @@
+-- Admins used to come from the api config, existing admin rows are promoted with the admin command
+ALTER TABLE app_user ADD COLUMN IF NOT EXISTS is_admin BOOLEAN NOT NULL DEFAULT FALSE;
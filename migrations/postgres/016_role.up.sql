@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS role (
+    name TEXT PRIMARY KEY
+);
+
+CREATE TABLE IF NOT EXISTS role_permission (
+    role_name TEXT NOT NULL,
+    permission TEXT NOT NULL,
+    PRIMARY KEY (role_name, permission),
+    FOREIGN KEY (role_name) REFERENCES role(name) ON DELETE CASCADE
+);
+
+-- Members and authors get their role from the user type, other roles are assigned
+CREATE TABLE IF NOT EXISTS user_role (
+    user_id UUID NOT NULL,
+    role_name TEXT NOT NULL,
+    PRIMARY KEY (user_id, role_name),
+    FOREIGN KEY (user_id) REFERENCES app_user(id) ON DELETE CASCADE,
+    FOREIGN KEY (role_name) REFERENCES role(name) ON DELETE CASCADE
+);
+
+INSERT INTO role (name) VALUES ('member'), ('author'), ('moderator')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO role_permission (role_name, permission) VALUES
+    ('member', 'album:manage'),
+    ('author', 'plant:edit'),
+    ('author', 'post:edit'),
+    ('author', 'category:manage'),
+    ('moderator', 'post:moderate'),
+    ('moderator', 'album:read_any')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS external_identity_member_id_idx;
+DROP TABLE IF EXISTS external_identity;
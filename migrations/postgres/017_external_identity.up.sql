@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS external_identity (
+    issuer TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    member_id UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (issuer, subject),
+    FOREIGN KEY (member_id) REFERENCES app_user(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS external_identity_member_id_idx ON external_identity (member_id);
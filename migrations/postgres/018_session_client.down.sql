@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS session_member_id_idx;
+
+ALTER TABLE "session" DROP COLUMN IF EXISTS ip;
+ALTER TABLE "session" DROP COLUMN IF EXISTS user_agent;
+ALTER TABLE "session" DROP COLUMN IF EXISTS last_seen_at;
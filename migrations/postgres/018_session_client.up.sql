@@ -0,0 +1,5 @@
+ALTER TABLE "session" ADD COLUMN IF NOT EXISTS last_seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+ALTER TABLE "session" ADD COLUMN IF NOT EXISTS user_agent TEXT NOT NULL DEFAULT '';
+ALTER TABLE "session" ADD COLUMN IF NOT EXISTS ip TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS session_member_id_idx ON "session" (member_id);
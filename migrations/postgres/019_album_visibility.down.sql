@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS album_public_idx;
+
+ALTER TABLE album DROP CONSTRAINT IF EXISTS album_visibility_check;
+ALTER TABLE album DROP COLUMN IF EXISTS share_token;
+ALTER TABLE album DROP COLUMN IF EXISTS visibility;
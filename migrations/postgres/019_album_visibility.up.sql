@@ -0,0 +1,6 @@
+ALTER TABLE album ADD COLUMN IF NOT EXISTS visibility TEXT NOT NULL DEFAULT 'private';
+ALTER TABLE album ADD COLUMN IF NOT EXISTS share_token TEXT UNIQUE;
+ALTER TABLE album DROP CONSTRAINT IF EXISTS album_visibility_check;
+ALTER TABLE album ADD CONSTRAINT album_visibility_check CHECK (visibility IN ('private', 'unlisted', 'public'));
+
+CREATE INDEX IF NOT EXISTS album_public_idx ON album (updated_at DESC) WHERE visibility = 'public';
@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS plant_search_vector_idx;
+ALTER TABLE plant DROP COLUMN IF EXISTS search_vector;
+
+ALTER TABLE plant ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian', coalesce(name, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(name, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(latin_name, '')), 'A') ||
+    setweight(to_tsvector('russian', coalesce(description, '')), 'B') ||
+    setweight(to_tsvector('english', coalesce(description, '')), 'B')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS plant_search_vector_idx ON plant USING GIN (search_vector);
@@ -0,0 +1 @@
+DELETE FROM role_permission WHERE permission = 'album:read';
@@ -0,0 +1,3 @@
+-- Reading own albums is a permission of its own, read-only api tokens keep it
+INSERT INTO role_permission (role_name, permission) VALUES ('member', 'album:read')
+ON CONFLICT DO NOTHING;